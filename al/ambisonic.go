@@ -0,0 +1,195 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Ambisonic (B-Format) and UHJ buffers, in pure Go.
+//
+// A B-Format buffer doesn't hold speaker channels but a
+// whole sound field, which OpenAL rotates to match the
+// source's orientation (see Source.SetOrientation()) and,
+// unless the source is relative, the listener's as well
+// (see Listener.SetOrientation()). It's not positioned or
+// attenuated like other sounds.
+
+package al
+
+// AL_EXT_BFORMAT formats for first order ambisonics, the
+// 32 bit ones use float32 samples. Channels are in FuMa
+// order (W, X, Y, Z) with FuMa normalization, unless you
+// say otherwise with a BFormat.
+const (
+	FormatBFormat2D8 = 0x20021;
+	FormatBFormat2D16 = 0x20022;
+	FormatBFormat2D32 = 0x20023;
+	FormatBFormat3D8 = 0x20031;
+	FormatBFormat3D16 = 0x20032;
+	FormatBFormat3D32 = 0x20033;
+)
+
+// AL_SOFT_UHJ formats for UHJ encoded surround sound; 2
+// channel UHJ plays back fine as plain stereo as well.
+const (
+	FormatUHJ2Chn8 = 0x19A2;
+	FormatUHJ2Chn16 = 0x19A3;
+	FormatUHJ2Chn32 = 0x19A4;
+	FormatUHJ3Chn8 = 0x19A5;
+	FormatUHJ3Chn16 = 0x19A6;
+	FormatUHJ3Chn32 = 0x19A7;
+	FormatUHJ4Chn8 = 0x19A8;
+	FormatUHJ4Chn16 = 0x19A9;
+	FormatUHJ4Chn32 = 0x19AA;
+)
+
+// Channel orderings for BFormat.
+const (
+	FuMaOrdering = 0x0000;
+	ACNOrdering = 0x0001;
+)
+
+// Channel normalizations for BFormat.
+const (
+	FuMaNormalization = 0x0000;
+	SN3DNormalization = 0x0001;
+	N3DNormalization = 0x0002;
+)
+
+// Buffer attributes for AL_SOFT_bformat_ex and
+// AL_SOFT_bformat_hoa.
+const (
+	alAmbisonicLayout = 0x1997;
+	alAmbisonicScaling = 0x1998;
+	alUnpackAmbisonicOrder = 0x199D;
+)
+
+// Source attributes for AL_SOFT_UHJ.
+const (
+	alStereoMode = 0x19B0;
+	alSuperStereoWidth = 0x19B1;
+)
+
+// Stereo modes for Source.SetStereoMode().
+const (
+	NormalStereo = 0x0000;
+	SuperStereo = 0x0001;
+)
+
+// BFormat describes ambisonic sample data for
+// Buffer.SetBFormatData(). The zero value (apart from
+// Bits) is first order 3D B-Format in the traditional
+// FuMa layout, which is what plain AL_EXT_BFORMAT takes.
+// Anything else needs AL_SOFT_bformat_ex, orders above 1
+// need AL_SOFT_bformat_hoa.
+//
+// Most ambisonic recordings these days are AmbiX, which
+// is ACNOrdering with SN3DNormalization.
+type BFormat struct {
+	Order int; // ambisonic order, 0 means 1
+	Horizontal bool; // 2D, no height channels
+	Ordering int32; // FuMaOrdering or ACNOrdering
+	Normalization int32; // FuMaNormalization, SN3DNormalization, or N3DNormalization
+	Bits int; // 8, 16, or 32 (float32)
+}
+
+// AmbiX is the usual first order layout for 16 bit data.
+var AmbiX = BFormat{1, false, ACNOrdering, SN3DNormalization, 16}
+
+func (self BFormat) order() int {
+	if self.Order == 0 {
+		return 1;
+	}
+	return self.Order;
+}
+
+// Channels() returns how many channels the sample data
+// has for the given order.
+func (self BFormat) Channels() int {
+	n := self.order();
+	if self.Horizontal {
+		return 2*n + 1;
+	}
+	return (n+1) * (n+1);
+}
+
+// Format() returns the format to pass to Buffer.SetData(),
+// or 0 for an unsupported number of Bits.
+func (self BFormat) Format() int32 {
+	formats := map[int]int32{8: FormatBFormat3D8, 16: FormatBFormat3D16, 32: FormatBFormat3D32};
+	if self.Horizontal {
+		formats = map[int]int32{8: FormatBFormat2D8, 16: FormatBFormat2D16, 32: FormatBFormat2D32};
+	}
+	return formats[self.Bits];
+}
+
+// SetBFormat() prepares the buffer for sample data in the
+// given layout, see SetBFormatData(). Buffers remember the
+// layout from last time, so with AL_SOFT_bformat_ex we set
+// every attribute each time; without it there's nothing to
+// set and only first order FuMa data works, anything else
+// is ErrInvalidValue. The order needs AL_SOFT_bformat_hoa,
+// asking for orders above 1 without it fails in OpenAL.
+func (self Buffer) SetBFormat(format BFormat) {
+	if !IsExtensionPresent("AL_SOFT_bformat_ex") {
+		if format.Ordering != FuMaOrdering || format.Normalization != FuMaNormalization || format.order() != 1 {
+			raise(InvalidValue);
+			if debugMode != DebugOff {
+				debugCheck(self, format);
+			}
+		}
+		return;
+	}
+	self.seti(alAmbisonicLayout, format.Ordering);
+	self.seti(alAmbisonicScaling, format.Normalization);
+	if format.order() != 1 || IsExtensionPresent("AL_SOFT_bformat_hoa") {
+		self.seti(alUnpackAmbisonicOrder, int32(format.order()));
+	}
+}
+
+// SetBFormatData() is like SetData() but for ambisonic
+// sample data in the given layout.
+// Convenience method, see Buffer.SetBFormat().
+func (self Buffer) SetBFormatData(format BFormat, data []byte, frequency int32) {
+	self.SetBFormat(format);
+	self.SetData(format.Format(), data, frequency);
+}
+
+// SetOrientation() rotates the sound field of a B-Format
+// source. Other sources ignore their orientation.
+// Convenience method, see Source.Setfv().
+func (self Source) SetOrientation(at Vector, up Vector) {
+	t := [6]float32{at[0], at[1], at[2], up[0], up[1], up[2]};
+	self.setfv(alOrientation, t[0:]);
+}
+
+// Convenience method, see Source.Getfv().
+func (self Source) GetOrientation() (at Vector, up Vector) {
+	t := [6]float32{};
+	self.getfv(alOrientation, t[0:]);
+	at = Vector{t[0], t[1], t[2]};
+	up = Vector{t[3], t[4], t[5]};
+	return;
+}
+
+// SetStereoMode() makes OpenAL treat stereo data played by
+// the source as UHJ (SuperStereo) to widen it, or not
+// (NormalStereo). Needs AL_SOFT_UHJ.
+// Convenience method, see Source.Seti().
+func (self Source) SetStereoMode(mode int32) {
+	self.seti(alStereoMode, mode);
+}
+
+// Convenience method, see Source.Geti().
+func (self Source) GetStereoMode() int32 {
+	return self.geti(alStereoMode);
+}
+
+// SetSuperStereoWidth() sets how much SuperStereo widens
+// the sound, from 0 to 1.
+// Convenience method, see Source.Setf().
+func (self Source) SetSuperStereoWidth(width float32) {
+	self.setf(alSuperStereoWidth, width);
+}
+
+// Convenience method, see Source.Getf().
+func (self Source) GetSuperStereoWidth() float32 {
+	return self.getf(alSuperStereoWidth);
+}
@@ -0,0 +1,84 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package al
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include "wrapper.h"
+*/
+import "C"
+import "sync"
+import "unsafe"
+
+// AL_SOFT_deferred_updates extension.
+const (
+	alDeferredUpdates = 0xC002;
+)
+
+// DeferUpdates() holds back all property changes (source
+// positions, listener orientation, and so on) from the
+// mixer until ProcessUpdates() is called, so they all take
+// effect in the same mix. Without AL_SOFT_deferred_updates
+// this does nothing and changes take effect right away.
+// Renamed, was DeferUpdatesSOFT.
+func DeferUpdates() {
+	C.walDeferUpdatesSOFT();
+	if debugMode != DebugOff {
+		debugCheck();
+	}
+}
+
+// ProcessUpdates() applies all property changes held back
+// since DeferUpdates() at once.
+// Renamed, was ProcessUpdatesSOFT.
+func ProcessUpdates() {
+	C.walProcessUpdatesSOFT();
+	if debugMode != DebugOff {
+		debugCheck();
+	}
+}
+
+// UpdatesDeferred() checks whether we're between
+// DeferUpdates() and ProcessUpdates().
+// Convenience function, see GetBoolean().
+func UpdatesDeferred() bool {
+	return getBoolean(alDeferredUpdates);
+}
+
+// Batch() nesting per context, so only the outermost
+// Batch() on a context processes its updates.
+var (
+	batchLock sync.Mutex;
+	batchDepth = make(map[unsafe.Pointer]int);
+)
+
+// Batch() calls f with updates deferred for the current
+// context, so everything f changes there reaches the mixer
+// at once. Calls to Batch() may be nested. OpenAL defers
+// updates for the whole context, so batches on the same
+// context share the deferral even across goroutines: the
+// updates are processed when the last of them returns (or
+// panics). Batches on other contexts don't interfere.
+// Convenience function, see DeferUpdates().
+func Batch(f func()) {
+	context := C.walCurrentContext();
+	batchLock.Lock();
+	if batchDepth[context] == 0 {
+		DeferUpdates();
+	}
+	batchDepth[context]++;
+	batchLock.Unlock();
+	defer func() {
+		batchLock.Lock();
+		batchDepth[context]--;
+		if batchDepth[context] == 0 {
+			delete(batchDepth, context);
+			onContext(context, ProcessUpdates);
+		}
+		batchLock.Unlock();
+	}();
+	f();
+}
@@ -23,53 +23,100 @@ const (
 	alSize = 0x2004;
 )
 
+// AL_SOFT_block_alignment attributes, see
+// Buffer.SetUnpackBlockAlignment().
+const (
+	alUnpackBlockAlignment = 0x200C;
+	alPackBlockAlignment = 0x200D;
+)
+
+// AL_SOFT_loop_points attribute, see Buffer.SetLoopPoints().
+const (
+	alLoopPoints = 0x2015;
+)
+
+// AL_SOFT_buffer_samples attribute, see Buffer.GetFrames().
+const (
+	alSampleLength = 0x200A;
+)
+
 // NewBuffers() creates n fresh buffers.
 // Renamed, was GenBuffers.
 func NewBuffers(n int) (buffers []Buffer) {
 	buffers = make([]Buffer, n);
 	C.walGenBuffers(C.ALsizei(n), unsafe.Pointer(&buffers[0]));
+	if debugMode != DebugOff {
+		debugCheck(n);
+	}
 	return;
 }
 
 // DeleteBuffers() deletes the given buffers.
 func DeleteBuffers(buffers []Buffer) {
 	n := len(buffers);
-	C.walDeleteBuffers(C.ALsizei(n), unsafe.Pointer(&buffers[0]));
+	if succeeded(func() { C.walDeleteBuffers(C.ALsizei(n), unsafe.Pointer(&buffers[0])) }) {
+		forgetCallbacks(buffers);
+	}
+	if debugMode != DebugOff {
+		debugCheck(buffers);
+	}
 }
 
 // Renamed, was Bufferf.
 func (self Buffer) setf(param int32, value float32) {
 	C.alBufferf(C.ALuint(self), C.ALenum(param), C.ALfloat(value));
+	if debugMode != DebugOff {
+		debugCheck(self, param, value);
+	}
 }
 
 // Renamed, was Buffer3f.
 func (self Buffer) set3f(param int32, value1, value2, value3 float32) {
 	C.alBuffer3f(C.ALuint(self), C.ALenum(param), C.ALfloat(value1), C.ALfloat(value2), C.ALfloat(value3));
+	if debugMode != DebugOff {
+		debugCheck(self, param, value1, value2, value3);
+	}
 }
 
 // Renamed, was Bufferfv.
 func (self Buffer) setfv(param int32, values []float32) {
 	C.walBufferfv(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, values);
+	}
 }
 
 // Renamed, was Bufferi.
 func (self Buffer) seti(param int32, value int32) {
 	C.alBufferi(C.ALuint(self), C.ALenum(param), C.ALint(value));
+	if debugMode != DebugOff {
+		debugCheck(self, param, value);
+	}
 }
 
 // Renamed, was Buffer3i.
 func (self Buffer) set3i(param int32, value1, value2, value3 int32) {
 	C.alBuffer3i(C.ALuint(self), C.ALenum(param), C.ALint(value1), C.ALint(value2), C.ALint(value3));
+	if debugMode != DebugOff {
+		debugCheck(self, param, value1, value2, value3);
+	}
 }
 
 // Renamed, was Bufferiv.
 func (self Buffer) setiv(param int32, values []int32) {
 	C.walBufferiv(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, values);
+	}
 }
 
 // Renamed, was GetBufferf.
 func (self Buffer) getf(param int32) float32 {
-	return float32(C.walGetBufferf(C.ALuint(self), C.ALenum(param)));
+	result := float32(C.walGetBufferf(C.ALuint(self), C.ALenum(param)));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
+	return result;
 }
 
 // Renamed, was GetBuffer3f.
@@ -77,6 +124,9 @@ func (self Buffer) get3f(param int32) (value1, value2, value3 float32) {
 	var v1, v2, v3 float32;
 	C.walGetBuffer3f(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&v1),
 		unsafe.Pointer(&v2), unsafe.Pointer(&v3));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
 	value1, value2, value3 = v1, v2, v3;
 	return;
 }
@@ -84,12 +134,19 @@ func (self Buffer) get3f(param int32) (value1, value2, value3 float32) {
 // Renamed, was GetBufferfv.
 func (self Buffer) getfv(param int32, values []float32) {
 	C.walGetBufferfv(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, values);
+	}
 	return;
 }
 
 // Renamed, was GetBufferi.
 func (self Buffer) geti(param int32) int32 {
-	return int32(C.walGetBufferi(C.ALuint(self), C.ALenum(param)));
+	result := int32(C.walGetBufferi(C.ALuint(self), C.ALenum(param)));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
+	return result;
 }
 
 // Renamed, was GetBuffer3i.
@@ -97,6 +154,9 @@ func (self Buffer) get3i(param int32) (value1, value2, value3 int32) {
 	var v1, v2, v3 int32;
 	C.walGetBuffer3i(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&v1),
 		unsafe.Pointer(&v2), unsafe.Pointer(&v3));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
 	value1, value2, value3 = v1, v2, v3;
 	return;
 }
@@ -104,6 +164,9 @@ func (self Buffer) get3i(param int32) (value1, value2, value3 int32) {
 // Renamed, was GetBufferiv.
 func (self Buffer) getiv(param int32, values []int32) {
 	C.walGetBufferiv(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, values);
+	}
 }
 
 // Format of sound samples passed to Buffer.SetData().
@@ -114,27 +177,203 @@ const (
 	FormatStereo16 = 0x1103;
 )
 
+// AL_EXT_float32 formats, samples are float32 in [-1, 1].
+const (
+	FormatMonoFloat32 = 0x10010;
+	FormatStereoFloat32 = 0x10011;
+)
+
+// AL_EXT_double formats, samples are float64 in [-1, 1].
+const (
+	FormatMonoDouble = 0x10012;
+	FormatStereoDouble = 0x10013;
+)
+
+// AL_EXT_MCFORMATS formats for multichannel sound, the
+// 32 bit ones use float32 samples. Channels are in the
+// usual WAV order: front left, front right, center, LFE,
+// then back and side channels.
+const (
+	FormatQuad8 = 0x1204;
+	FormatQuad16 = 0x1205;
+	FormatQuad32 = 0x1206;
+	FormatRear8 = 0x1207;
+	FormatRear16 = 0x1208;
+	FormatRear32 = 0x1209;
+	Format51Chn8 = 0x120A;
+	Format51Chn16 = 0x120B;
+	Format51Chn32 = 0x120C;
+	Format61Chn8 = 0x120D;
+	Format61Chn16 = 0x120E;
+	Format61Chn32 = 0x120F;
+	Format71Chn8 = 0x1210;
+	Format71Chn16 = 0x1211;
+	Format71Chn32 = 0x1212;
+)
+
+// Compressed formats, see Buffer.SetData(). IMA4 and
+// MSADPCM come in blocks, see SetUnpackBlockAlignment().
+const (
+	FormatMonoMulaw = 0x10014; // AL_EXT_MULAW
+	FormatStereoMulaw = 0x10015;
+	FormatMonoAlaw = 0x10016; // AL_EXT_ALAW
+	FormatStereoAlaw = 0x10017;
+	FormatMonoIMA4 = 0x1300; // AL_EXT_IMA4
+	FormatStereoIMA4 = 0x1301;
+	FormatMonoMSADPCM = 0x1302; // AL_SOFT_MSADPCM
+	FormatStereoMSADPCM = 0x1303;
+)
+
 // SetData() specifies the sample data the buffer should use.
-// For FormatMono16 and FormatStereo8 the data slice must be a
-// multiple of two bytes long; for FormatStereo16 the data slice
-// must be a multiple of four bytes long. The frequency is given
-// in Hz.
+// The data slice must hold whole sample frames, see
+// FrameSize(); for FormatStereo16 for example it must be a
+// multiple of four bytes long. The frequency is given in Hz.
+//
+// Compressed formats (FormatMonoIMA4 for example) work even
+// if OpenAL lacks the extension for them: we decode them
+// into 16 bit samples ourselves in that case, see decode.go.
 // Renamed, was BufferData.
 func (self Buffer) SetData(format int32, data []byte, frequency int32) {
-	C.alBufferData(C.ALuint(self), C.ALenum(format), unsafe.Pointer(&data[0]),
-		C.ALsizei(len(data)), C.ALsizei(frequency));
+	if c, ok := compressedFormats[format]; ok && !IsExtensionPresent(c.extension) {
+		self.setDecodedData(c, data, frequency, 0);
+		return;
+	}
+	self.setData(format, unsafe.Pointer(&data[0]), len(data), frequency);
+	if debugMode != DebugOff {
+		debugCheck(self, format, data, frequency);
+	}
+}
+
+// SetCompressedData() is like SetData() but first sets the
+// block alignment, in sample frames, for IMA4 and MSADPCM
+// data. An alignment of 0 means the default, 65 frames for
+// IMA4 and 64 for MSADPCM. Other alignments need the
+// AL_SOFT_block_alignment extension, without it we decode
+// the data ourselves, see SetData().
+func (self Buffer) SetCompressedData(format int32, data []byte, frequency int32, align int32) {
+	c, ok := compressedFormats[format];
+	if !ok || !c.blocks {
+		self.SetData(format, data, frequency);
+		return;
+	}
+	if !IsExtensionPresent(c.extension) || (align != 0 && !IsExtensionPresent("AL_SOFT_block_alignment")) {
+		self.setDecodedData(c, data, frequency, int(align));
+		return;
+	}
+	if IsExtensionPresent("AL_SOFT_block_alignment") {
+		self.SetUnpackBlockAlignment(align);
+	}
+	self.SetData(format, data, frequency);
+}
+
+// setDecodedData() decodes compressed data in Go and hands
+// OpenAL the resulting 16 bit samples. Data OpenAL would
+// reject gets ErrInvalidValue and leaves the buffer alone.
+func (self Buffer) setDecodedData(c compressedFormat, data []byte, frequency int32, align int) {
+	if align == 0 && c.blocks && IsExtensionPresent("AL_SOFT_block_alignment") {
+		align = int(self.GetUnpackBlockAlignment());
+	}
+	samples, err := c.decode(data, c.channels, align);
+	if err != nil {
+		raise(InvalidValue);
+		if debugMode != DebugOff {
+			debugCheck(self, data, frequency, align);
+		}
+		return;
+	}
+	format := int32(FormatMono16);
+	if c.channels == 2 {
+		format = FormatStereo16;
+	}
+	if len(samples) == 0 {
+		self.setData(format, nil, 0, frequency);
+		if debugMode != DebugOff {
+			debugCheck(self, format, samples, frequency);
+		}
+		return;
+	}
+	self.SetDataInt16(format, samples, frequency);
+}
+
+// SetUnpackBlockAlignment() sets how many sample frames make
+// up a block of IMA4 or MSADPCM data passed to SetData(), 0
+// meaning the default. Needs AL_SOFT_block_alignment.
+// Convenience method, see Buffer.Seti().
+func (self Buffer) SetUnpackBlockAlignment(frames int32) {
+	self.seti(alUnpackBlockAlignment, frames);
+}
+
+// Convenience method, see Buffer.Geti().
+func (self Buffer) GetUnpackBlockAlignment() int32 {
+	return self.geti(alUnpackBlockAlignment);
+}
+
+// SetPackBlockAlignment() is the counterpart to
+// SetUnpackBlockAlignment() for reading samples back.
+// Convenience method, see Buffer.Seti().
+func (self Buffer) SetPackBlockAlignment(frames int32) {
+	self.seti(alPackBlockAlignment, frames);
+}
+
+// Convenience method, see Buffer.Geti().
+func (self Buffer) GetPackBlockAlignment() int32 {
+	return self.geti(alPackBlockAlignment);
+}
+
+// SetDataInt16() is like SetData() but takes 16 bit samples
+// directly, for FormatMono16 or FormatStereo16 for example.
+func (self Buffer) SetDataInt16(format int32, data []int16, frequency int32) {
+	self.setData(format, unsafe.Pointer(&data[0]), len(data) * 2, frequency);
+	if debugMode != DebugOff {
+		debugCheck(self, format, data, frequency);
+	}
+}
+
+// SetDataFloat32() is like SetData() but takes float32
+// samples directly, for FormatMonoFloat32 for example.
+func (self Buffer) SetDataFloat32(format int32, data []float32, frequency int32) {
+	self.setData(format, unsafe.Pointer(&data[0]), len(data) * 4, frequency);
+	if debugMode != DebugOff {
+		debugCheck(self, format, data, frequency);
+	}
+}
+
+// setData() hands OpenAL sample data for the buffer. If
+// it was a callback buffer so far, we let go of the
+// callback once OpenAL took the data.
+func (self Buffer) setData(format int32, data unsafe.Pointer, size int, frequency int32) {
+	call := func() {
+		C.alBufferData(C.ALuint(self), C.ALenum(format), data,
+			C.ALsizei(size), C.ALsizei(frequency));
+	};
+	if !hasCallback(self) {
+		call();
+		return;
+	}
+	if succeeded(call) {
+		forgetCallbacks([]Buffer{self});
+	}
 }
 
 // NewBuffer() creates a single buffer.
 // Convenience function, see NewBuffers().
 func NewBuffer() Buffer {
-	return Buffer(C.walGenBuffer());
+	result := Buffer(C.walGenBuffer());
+	if debugMode != DebugOff {
+		debugCheck();
+	}
+	return result;
 }
 
 // DeleteBuffer() deletes a single buffer.
 // Convenience function, see DeleteBuffers().
 func DeleteBuffer(buffer Buffer) {
-	C.walDeleteSource(C.ALuint(buffer));
+	if succeeded(func() { C.walDeleteBuffer(C.ALuint(buffer)) }) {
+		forgetCallbacks([]Buffer{buffer});
+	}
+	if debugMode != DebugOff {
+		debugCheck(buffer);
+	}
 }
 
 // GetFrequency() returns the frequency, in Hz, of the buffer's sample data.
@@ -143,13 +382,13 @@ func (self Buffer) GetFrequency() uint32 {
 	return uint32(self.geti(alFrequency));
 }
 
-// GetBits() returns the resolution, either 8 or 16 bits, of the buffer's sample data.
+// GetBits() returns the resolution, for example 8 or 16 bits, of the buffer's sample data.
 // Convenience method.
 func (self Buffer) GetBits() uint32 {
 	return uint32(self.geti(alBits));
 }
 
-// GetChannels() returns the number of channels, either 1 or 2, of the buffer's sample data.
+// GetChannels() returns the number of channels, for example 1 or 2, of the buffer's sample data.
 // Convenience method.
 func (self Buffer) GetChannels() uint32 {
 	return uint32(self.geti(alChannels));
@@ -160,3 +399,48 @@ func (self Buffer) GetChannels() uint32 {
 func (self Buffer) GetSize() uint32 {
 	return uint32(self.geti(alSize));
 }
+
+// GetFrames() returns the length, in sample frames, of the
+// buffer's sample data. OpenAL Soft knows the length even
+// for IMA4 and MSADPCM blocks, elsewhere we work it out
+// from the size, which only works for uncompressed formats.
+// Convenience method.
+func (self Buffer) GetFrames() int32 {
+	if GetEnumValue("AL_SAMPLE_LENGTH_SOFT") == alSampleLength {
+		return self.geti(alSampleLength);
+	}
+	bits := int64(self.GetBits()) * int64(self.GetChannels());
+	if bits == 0 {
+		return 0;
+	}
+	return int32(int64(self.GetSize()) * 8 / bits);
+}
+
+// SetLoopPoints() makes looping sources (see
+// Source.SetLooping()) only loop the frames from start up
+// to end, so the frames before start play just once, as
+// an intro. The loop points must fit the buffer's sample
+// data (see GetFrames()), otherwise we get InvalidValue
+// and the buffer is left alone. The buffer must not be
+// attached to a source while we do this.
+// Needs AL_SOFT_loop_points.
+// Convenience method, see Buffer.Setiv().
+func (self Buffer) SetLoopPoints(start, end int32) {
+	if start < 0 || end <= start || end > self.GetFrames() {
+		raise(InvalidValue);
+		if debugMode != DebugOff {
+			debugCheck(self, start, end);
+		}
+		return;
+	}
+	self.setiv(alLoopPoints, []int32{start, end});
+}
+
+// GetLoopPoints() returns the loop points, which default
+// to the whole buffer.
+// Convenience method, see Buffer.Getiv().
+func (self Buffer) GetLoopPoints() (start, end int32) {
+	t := []int32{0, 0};
+	self.getiv(alLoopPoints, t);
+	return t[0], t[1];
+}
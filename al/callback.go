@@ -0,0 +1,156 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package al
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include "wrapper.h"
+*/
+import "C"
+import "sync"
+import "unsafe"
+
+// BufferCallback fills dst with samples in the format the
+// buffer was set up with and returns how many bytes it
+// wrote. Returning less than len(dst) ends the sound, the
+// source stops once it has played what it got.
+//
+// OpenAL calls it from its mixer thread, so it must be
+// quick and must not call back into OpenAL.
+type BufferCallback func(dst []byte) int
+
+// The callbacks of all callback buffers, so the garbage
+// collector keeps them alive while OpenAL can still call
+// them. Every device has its own buffer names, so we key
+// them by the device of the current context as well, and
+// we pass OpenAL an id of our own as the user pointer to
+// find them again. Buffers must be set up and deleted with
+// a context of their device current, as OpenAL requires
+// anyway. We let go of a callback once its buffer gets
+// other sample data, is deleted, or its device is closed.
+type callbackKey struct {
+	device uintptr;
+	buffer Buffer;
+}
+
+var (
+	callbackLock sync.RWMutex;
+	callbacks = make(map[C.ALuint]BufferCallback);
+	callbackIDs = make(map[callbackKey]C.ALuint);
+	lastCallbackID C.ALuint;
+)
+
+func currentCallbackKey(buffer Buffer) callbackKey {
+	return callbackKey{uintptr(C.walCurrentDevice()), buffer};
+}
+
+// succeeded() runs f and checks whether OpenAL took the
+// call, without taking errors away from GetError(): we
+// raise() whichever error OpenAL would have kept, the one
+// from before f if there was one.
+func succeeded(f func()) bool {
+	before := takeError();
+	f();
+	after := takeError();
+	if e, ok := before.(Error); ok {
+		raise(int32(e));
+	} else if e, ok := after.(Error); ok {
+		raise(int32(e));
+	}
+	return after == nil;
+}
+
+// SetCallback() turns the buffer into a callback buffer:
+// instead of holding sample data, it asks f for more
+// whenever a source playing it needs more. This is how to
+// stream or synthesize sound without queueing buffers.
+// Needs AL_SOFT_callback_buffer, without it we report
+// InvalidOperation.
+// Renamed, was BufferCallbackSOFT.
+func (self Buffer) SetCallback(format int32, frequency int32, f BufferCallback) {
+	if !IsExtensionPresent("AL_SOFT_callback_buffer") {
+		raise(InvalidOperation);
+		if debugMode != DebugOff {
+			debugCheck(self, format, frequency);
+		}
+		return;
+	}
+	key := currentCallbackKey(self);
+	callbackLock.Lock();
+	lastCallbackID++;
+	id := lastCallbackID;
+	callbackLock.Unlock();
+	ok := succeeded(func() {
+		C.walBufferCallbackSOFT(C.ALuint(self), C.ALenum(format), C.ALsizei(frequency), id);
+	});
+	if ok {
+		// OpenAL won't call f before a source plays the
+		// buffer, so registering it now is soon enough.
+		callbackLock.Lock();
+		delete(callbacks, callbackIDs[key]);
+		callbacks[id] = f;
+		callbackIDs[key] = id;
+		callbackLock.Unlock();
+	}
+	if debugMode != DebugOff {
+		debugCheck(self, format, frequency);
+	}
+}
+
+// forgetCallbacks() lets go of the callbacks for buffers
+// that have been deleted.
+func forgetCallbacks(buffers []Buffer) {
+	callbackLock.Lock();
+	for _, b := range buffers {
+		key := currentCallbackKey(b);
+		if id, ok := callbackIDs[key]; ok {
+			delete(callbacks, id);
+			delete(callbackIDs, key);
+		}
+	}
+	callbackLock.Unlock();
+}
+
+// hasCallback() checks whether the buffer has a callback.
+func hasCallback(buffer Buffer) bool {
+	key := currentCallbackKey(buffer);
+	callbackLock.RLock();
+	_, ok := callbackIDs[key];
+	callbackLock.RUnlock();
+	return ok;
+}
+
+// ForgetDevice() lets go of the callbacks for all buffers
+// of a device. The device is an ALCdevice pointer,
+// openal/alc calls this when it closes one.
+func ForgetDevice(device unsafe.Pointer) {
+	callbackLock.Lock();
+	for key, id := range callbackIDs {
+		if key.device == uintptr(device) {
+			delete(callbacks, id);
+			delete(callbackIDs, key);
+		}
+	}
+	callbackLock.Unlock();
+}
+
+//export walBufferCallback
+func walBufferCallback(id C.ALuint, data unsafe.Pointer, size C.ALsizei) C.ALsizei {
+	callbackLock.RLock();
+	f := callbacks[id];
+	callbackLock.RUnlock();
+	if f == nil || size <= 0 {
+		return 0;
+	}
+	dst := (*[1 << 30]byte)(data)[0:size:size];
+	n := f(dst);
+	if n < 0 {
+		n = 0;
+	} else if n > int(size) {
+		n = int(size);
+	}
+	return C.ALsizei(n);
+}
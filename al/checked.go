@@ -0,0 +1,330 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Checked variants of the mutating calls, in pure Go.
+//
+// OpenAL only reports errors through GetError(), which
+// makes it far too easy to forget checking them and hard
+// to tell which call caused a given error. Each function
+// here calls its unchecked namesake and returns the error
+// generated by exactly that call. Errors that were still
+// pending before the call are discarded so they can't be
+// blamed on the wrong call.
+
+package al
+
+import "time"
+
+// check() calls f and returns the AL error it caused.
+// In debug mode the error has already been consumed by
+// debugCheck(), so we pick it up from there. OpenAL keeps
+// one error for everybody, so goroutines making checked
+// calls at the same time may still get each other's.
+func check(f func()) error {
+	takeError();
+	takeDebugError();
+	f();
+	if err := takeError(); err != nil {
+		return err;
+	}
+	return takeDebugError();
+}
+
+///// Global /////////////////////////////////////////////////////////
+
+// Checked variant, see SetDopplerFactor().
+func SetDopplerFactorChecked(value float32) error {
+	return check(func() { SetDopplerFactor(value) });
+}
+
+// Checked variant, see SetDopplerVelocity().
+func SetDopplerVelocityChecked(value float32) error {
+	return check(func() { SetDopplerVelocity(value) });
+}
+
+// Checked variant, see SetSpeedOfSound().
+func SetSpeedOfSoundChecked(value float32) error {
+	return check(func() { SetSpeedOfSound(value) });
+}
+
+// Checked variant, see SetDistanceModel().
+func SetDistanceModelChecked(model int32) error {
+	return check(func() { SetDistanceModel(model) });
+}
+
+///// Buffers ////////////////////////////////////////////////////////
+
+// Checked variant, see NewBuffers().
+func NewBuffersChecked(n int) (buffers []Buffer, err error) {
+	err = check(func() { buffers = NewBuffers(n) });
+	return;
+}
+
+// Checked variant, see DeleteBuffers().
+func DeleteBuffersChecked(buffers []Buffer) error {
+	return check(func() { DeleteBuffers(buffers) });
+}
+
+// Checked variant, see NewBuffer().
+func NewBufferChecked() (buffer Buffer, err error) {
+	err = check(func() { buffer = NewBuffer() });
+	return;
+}
+
+// Checked variant, see DeleteBuffer().
+func DeleteBufferChecked(buffer Buffer) error {
+	return check(func() { DeleteBuffer(buffer) });
+}
+
+// Checked variant, see Buffer.SetData().
+func (self Buffer) SetDataChecked(format int32, data []byte, frequency int32) error {
+	return check(func() { self.SetData(format, data, frequency) });
+}
+
+// Checked variant, see Buffer.SetCompressedData().
+func (self Buffer) SetCompressedDataChecked(format int32, data []byte, frequency int32, align int32) error {
+	return check(func() { self.SetCompressedData(format, data, frequency, align) });
+}
+
+// Checked variant, see Buffer.SetUnpackBlockAlignment().
+func (self Buffer) SetUnpackBlockAlignmentChecked(frames int32) error {
+	return check(func() { self.SetUnpackBlockAlignment(frames) });
+}
+
+// Checked variant, see Buffer.SetBFormatData().
+func (self Buffer) SetBFormatDataChecked(format BFormat, data []byte, frequency int32) error {
+	return check(func() { self.SetBFormatData(format, data, frequency) });
+}
+
+// Checked variant, see Buffer.SetLoopPoints().
+func (self Buffer) SetLoopPointsChecked(start, end int32) error {
+	return check(func() { self.SetLoopPoints(start, end) });
+}
+
+// Checked variant, see Buffer.SetCallback().
+func (self Buffer) SetCallbackChecked(format int32, frequency int32, f BufferCallback) error {
+	return check(func() { self.SetCallback(format, frequency, f) });
+}
+
+// Checked variant, see Buffer.SetDataInt16().
+func (self Buffer) SetDataInt16Checked(format int32, data []int16, frequency int32) error {
+	return check(func() { self.SetDataInt16(format, data, frequency) });
+}
+
+// Checked variant, see Buffer.SetDataFloat32().
+func (self Buffer) SetDataFloat32Checked(format int32, data []float32, frequency int32) error {
+	return check(func() { self.SetDataFloat32(format, data, frequency) });
+}
+
+///// Sources ////////////////////////////////////////////////////////
+
+// Checked variant, see NewSources().
+func NewSourcesChecked(n int) (sources []Source, err error) {
+	err = check(func() { sources = NewSources(n) });
+	return;
+}
+
+// Checked variant, see DeleteSources().
+func DeleteSourcesChecked(sources []Source) error {
+	return check(func() { DeleteSources(sources) });
+}
+
+// Checked variant, see NewSource().
+func NewSourceChecked() (source Source, err error) {
+	err = check(func() { source = NewSource() });
+	return;
+}
+
+// Checked variant, see DeleteSource().
+func DeleteSourceChecked(source Source) error {
+	return check(func() { DeleteSource(source) });
+}
+
+// Checked variant, see PlaySources().
+func PlaySourcesChecked(sources []Source) error {
+	return check(func() { PlaySources(sources) });
+}
+
+// Checked variant, see PlaySourcesAtTime().
+func PlaySourcesAtTimeChecked(sources []Source, clock time.Duration) error {
+	return check(func() { PlaySourcesAtTime(sources, clock) });
+}
+
+// Checked variant, see StopSources().
+func StopSourcesChecked(sources []Source) error {
+	return check(func() { StopSources(sources) });
+}
+
+// Checked variant, see RewindSources().
+func RewindSourcesChecked(sources []Source) error {
+	return check(func() { RewindSources(sources) });
+}
+
+// Checked variant, see PauseSources().
+func PauseSourcesChecked(sources []Source) error {
+	return check(func() { PauseSources(sources) });
+}
+
+// Checked variant, see Source.Play().
+func (self Source) PlayChecked() error {
+	return check(func() { self.Play() });
+}
+
+// Checked variant, see Source.PlayAtTime().
+func (self Source) PlayAtTimeChecked(clock time.Duration) error {
+	return check(func() { self.PlayAtTime(clock) });
+}
+
+// Checked variant, see Source.Stop().
+func (self Source) StopChecked() error {
+	return check(func() { self.Stop() });
+}
+
+// Checked variant, see Source.Rewind().
+func (self Source) RewindChecked() error {
+	return check(func() { self.Rewind() });
+}
+
+// Checked variant, see Source.Pause().
+func (self Source) PauseChecked() error {
+	return check(func() { self.Pause() });
+}
+
+// Checked variant, see Source.QueueBuffers().
+func (self Source) QueueBuffersChecked(buffers []Buffer) error {
+	return check(func() { self.QueueBuffers(buffers) });
+}
+
+// Checked variant, see Source.UnqueueBuffers().
+func (self Source) UnqueueBuffersChecked(buffers []Buffer) error {
+	return check(func() { self.UnqueueBuffers(buffers) });
+}
+
+// Checked variant, see Source.QueueBuffer().
+func (self Source) QueueBufferChecked(buffer Buffer) error {
+	return check(func() { self.QueueBuffer(buffer) });
+}
+
+// Checked variant, see Source.UnqueueBuffer().
+func (self Source) UnqueueBufferChecked() (buffer Buffer, err error) {
+	err = check(func() { buffer = self.UnqueueBuffer() });
+	return;
+}
+
+// Checked variant, see Source.SetGain().
+func (self Source) SetGainChecked(gain float32) error {
+	return check(func() { self.SetGain(gain) });
+}
+
+// Checked variant, see Source.SetMinGain().
+func (self Source) SetMinGainChecked(gain float32) error {
+	return check(func() { self.SetMinGain(gain) });
+}
+
+// Checked variant, see Source.SetMaxGain().
+func (self Source) SetMaxGainChecked(gain float32) error {
+	return check(func() { self.SetMaxGain(gain) });
+}
+
+// Checked variant, see Source.SetReferenceDistance().
+func (self Source) SetReferenceDistanceChecked(distance float32) error {
+	return check(func() { self.SetReferenceDistance(distance) });
+}
+
+// Checked variant, see Source.SetMaxDistance().
+func (self Source) SetMaxDistanceChecked(distance float32) error {
+	return check(func() { self.SetMaxDistance(distance) });
+}
+
+// Checked variant, see Source.SetPitch().
+func (self Source) SetPitchChecked(pitch float32) error {
+	return check(func() { self.SetPitch(pitch) });
+}
+
+// Checked variant, see Source.SetRolloffFactor().
+func (self Source) SetRolloffFactorChecked(factor float32) error {
+	return check(func() { self.SetRolloffFactor(factor) });
+}
+
+// Checked variant, see Source.SetLooping().
+func (self Source) SetLoopingChecked(yes bool) error {
+	return check(func() { self.SetLooping(yes) });
+}
+
+// Checked variant, see Source.SetSourceRelative().
+func (self Source) SetSourceRelativeChecked(yes bool) error {
+	return check(func() { self.SetSourceRelative(yes) });
+}
+
+// Checked variant, see Source.SetPosition().
+func (self Source) SetPositionChecked(vector Vector) error {
+	return check(func() { self.SetPosition(vector) });
+}
+
+// Checked variant, see Source.SetDirection().
+func (self Source) SetDirectionChecked(vector Vector) error {
+	return check(func() { self.SetDirection(vector) });
+}
+
+// Checked variant, see Source.SetVelocity().
+func (self Source) SetVelocityChecked(vector Vector) error {
+	return check(func() { self.SetVelocity(vector) });
+}
+
+// Checked variant, see Source.SetOffsetSeconds().
+func (self Source) SetOffsetSecondsChecked(offset float32) error {
+	return check(func() { self.SetOffsetSeconds(offset) });
+}
+
+// Checked variant, see Source.SetOffsetSamples().
+func (self Source) SetOffsetSamplesChecked(offset int32) error {
+	return check(func() { self.SetOffsetSamples(offset) });
+}
+
+// Checked variant, see Source.SetOffsetBytes().
+func (self Source) SetOffsetBytesChecked(offset int32) error {
+	return check(func() { self.SetOffsetBytes(offset) });
+}
+
+// Checked variant, see Source.SetInnerAngle().
+func (self Source) SetInnerAngleChecked(angle float32) error {
+	return check(func() { self.SetInnerAngle(angle) });
+}
+
+// Checked variant, see Source.SetOuterAngle().
+func (self Source) SetOuterAngleChecked(angle float32) error {
+	return check(func() { self.SetOuterAngle(angle) });
+}
+
+// Checked variant, see Source.SetOuterGain().
+func (self Source) SetOuterGainChecked(gain float32) error {
+	return check(func() { self.SetOuterGain(gain) });
+}
+
+// Checked variant, see Source.SetBuffer().
+func (self Source) SetBufferChecked(buffer Buffer) error {
+	return check(func() { self.SetBuffer(buffer) });
+}
+
+///// Listener ///////////////////////////////////////////////////////
+
+// Checked variant, see Listener.SetGain().
+func (self Listener) SetGainChecked(gain float32) error {
+	return check(func() { self.SetGain(gain) });
+}
+
+// Checked variant, see Listener.SetPosition().
+func (self Listener) SetPositionChecked(vector Vector) error {
+	return check(func() { self.SetPosition(vector) });
+}
+
+// Checked variant, see Listener.SetVelocity().
+func (self Listener) SetVelocityChecked(vector Vector) error {
+	return check(func() { self.SetVelocity(vector) });
+}
+
+// Checked variant, see Listener.SetOrientation().
+func (self Listener) SetOrientationChecked(at Vector, up Vector) error {
+	return check(func() { self.SetOrientation(at, up) });
+}
@@ -94,6 +94,7 @@ package al
 #include "wrapper.h"
 */
 import "C"
+import "sync"
 import "unsafe"
 
 // General purpose constants. None can be used with SetDistanceModel()
@@ -133,43 +134,102 @@ const (
 )
 
 func GetString(param int32) string {
-	return C.GoString(C.walGetString(C.ALenum(param)));
+	result := C.GoString(C.walGetString(C.ALenum(param)));
+	if debugMode != DebugOff {
+		debugCheck(param);
+	}
+	return result;
+}
+
+// IsExtensionPresent() checks whether the named extension,
+// for example "AL_SOFT_loop_points", is supported by the
+// current context.
+func IsExtensionPresent(name string) bool {
+	p := C.CString(name);
+	result := C.walIsExtensionPresent(p) != alFalse;
+	C.free(unsafe.Pointer(p));
+	if debugMode != DebugOff {
+		debugCheck(name);
+	}
+	return result;
+}
+
+// GetEnumValue() returns the value of the named enum, for
+// example "AL_LOOP_POINTS_SOFT", or 0 if there's no such
+// enum in the current context. Most of the time you'll
+// want to use the constants we define instead.
+func GetEnumValue(name string) int32 {
+	p := C.CString(name);
+	result := int32(C.walGetEnumValue(p));
+	C.free(unsafe.Pointer(p));
+	if debugMode != DebugOff {
+		debugCheck(name);
+	}
+	return result;
 }
 
 func getBoolean(param int32) bool {
-	return C.alGetBoolean(C.ALenum(param)) != alFalse;
+	result := C.alGetBoolean(C.ALenum(param)) != alFalse;
+	if debugMode != DebugOff {
+		debugCheck(param);
+	}
+	return result;
 }
 
 func getInteger(param int32) int32 {
-	return int32(C.alGetInteger(C.ALenum(param)));
+	result := int32(C.alGetInteger(C.ALenum(param)));
+	if debugMode != DebugOff {
+		debugCheck(param);
+	}
+	return result;
 }
 
 func getFloat(param int32) float32 {
-	return float32(C.alGetFloat(C.ALenum(param)));
+	result := float32(C.alGetFloat(C.ALenum(param)));
+	if debugMode != DebugOff {
+		debugCheck(param);
+	}
+	return result;
 }
 
 func getDouble(param int32) float64 {
-	return float64(C.alGetDouble(C.ALenum(param)));
+	result := float64(C.alGetDouble(C.ALenum(param)));
+	if debugMode != DebugOff {
+		debugCheck(param);
+	}
+	return result;
 }
 
 // Renamed, was GetBooleanv.
 func getBooleans(param int32, data []bool) {
 	C.walGetBooleanv(C.ALenum(param), unsafe.Pointer(&data[0]));
+	if debugMode != DebugOff {
+		debugCheck(param, data);
+	}
 }
 
 // Renamed, was GetIntegerv.
 func getIntegers(param int32, data []int32) {
 	C.walGetIntegerv(C.ALenum(param), unsafe.Pointer(&data[0]));
+	if debugMode != DebugOff {
+		debugCheck(param, data);
+	}
 }
 
 // Renamed, was GetFloatv.
 func getFloats(param int32, data []float32) {
 	C.walGetFloatv(C.ALenum(param), unsafe.Pointer(&data[0]));
+	if debugMode != DebugOff {
+		debugCheck(param, data);
+	}
 }
 
 // Renamed, was GetDoublev.
 func getDoubles(param int32, data []float64) {
 	C.walGetDoublev(C.ALenum(param), unsafe.Pointer(&data[0]));
+	if debugMode != DebugOff {
+		debugCheck(param, data);
+	}
 }
 
 // Error codes from GetError()/for GetString().
@@ -179,27 +239,84 @@ const (
 	InvalidEnum = 0xA002;
 	InvalidValue = 0xA003;
 	InvalidOperation = 0xA004;
+	OutOfMemory = 0xA005;
+)
+
+// Errors we find ourselves, in Go, before OpenAL gets to
+// see the call. Like OpenAL we only keep the first error,
+// so raise() takes over an error OpenAL still has pending.
+var (
+	raisedLock sync.Mutex;
+	raised error;
 )
 
+// raise() records an error for GetError().
+func raise(code int32) {
+	raisedLock.Lock();
+	if raised == nil {
+		if pending := int32(C.alGetError()); pending != NoError {
+			code = pending;
+		}
+		raised = Error(code);
+	}
+	raisedLock.Unlock();
+}
+
 // GetError() returns the most recent error generated
-// in the AL state machine.
-func GetError() uint32 {
-	return uint32(C.alGetError());
+// in the AL state machine, or nil if there was none.
+// The error is an Error value, see error.go. This
+// includes errors the package finds itself before
+// calling OpenAL, whichever came first.
+func GetError() error {
+	raisedLock.Lock();
+	defer raisedLock.Unlock();
+	if raised != nil {
+		// Anything OpenAL has pending came later, we
+		// leave it for the next call.
+		err := raised;
+		raised = nil;
+		return err;
+	}
+	code := int32(C.alGetError());
+	if code == NoError {
+		return nil;
+	}
+	return Error(code);
+}
+
+// takeError() clears our error and OpenAL's both and
+// returns the one that came first, for code that needs
+// a clean slate before a call.
+func takeError() error {
+	err := GetError();
+	if later := GetError(); err == nil {
+		err = later;
+	}
+	return err;
 }
 
 // Renamed, was DopplerFactor.
 func SetDopplerFactor (value float32) {
 	C.alDopplerFactor(C.ALfloat(value));
+	if debugMode != DebugOff {
+		debugCheck(value);
+	}
 }
 
 // Renamed, was DopplerVelocity.
 func SetDopplerVelocity (value float32) {
 	C.alDopplerVelocity(C.ALfloat(value));
+	if debugMode != DebugOff {
+		debugCheck(value);
+	}
 }
 
 // Renamed, was SpeedOfSound.
 func SetSpeedOfSound (value float32) {
 	C.alSpeedOfSound(C.ALfloat(value));
+	if debugMode != DebugOff {
+		debugCheck(value);
+	}
 }
 
 // Distance models for SetDistanceModel() and GetDistanceModel().
@@ -217,6 +334,9 @@ const (
 // Renamed, was DistanceModel.
 func SetDistanceModel(model int32) {
 	C.alDistanceModel(C.ALenum(model));
+	if debugMode != DebugOff {
+		debugCheck(model);
+	}
 }
 
 ///// Crap ///////////////////////////////////////////////////////////
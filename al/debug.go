@@ -0,0 +1,147 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Debug mode in pure Go.
+//
+// In debug mode every wrapped call checks GetError() right
+// after calling into OpenAL and reports any error together
+// with the Go function that was called, the arguments that
+// went to OpenAL and the file:line of the caller. This is
+// much better than sprinkling GetError() calls all over
+// client code while hunting down a problem.
+//
+// Since debug mode consumes errors as soon as they occur,
+// GetError() will never return anything interesting while
+// it is on. The checked variants of mutating calls still
+// get to see the error though.
+
+package al
+
+import (
+	"fmt";
+	"log";
+	"reflect";
+	"runtime";
+	"strings";
+)
+
+// Debug modes for SetDebug().
+const (
+	DebugOff = iota;
+	DebugLog;
+	DebugPanic;
+)
+
+var debugMode = DebugOff;
+
+// The first error reported in debug mode since check()
+// last looked, guarded by raisedLock.
+var debugError error;
+
+// SetDebug() changes the current debug mode. DebugLog
+// logs every error with the log package, DebugPanic
+// panics instead. DebugOff, the default, turns all
+// checks off again.
+func SetDebug(mode int) {
+	debugMode = mode;
+}
+
+// GetDebug() returns the current debug mode.
+func GetDebug() int {
+	return debugMode;
+}
+
+// debugCheck() is called after every wrapped call while
+// debug mode is on; args are the arguments of the call.
+func debugCheck(args ...interface{}) {
+	err := GetError();
+	if err == nil {
+		return;
+	}
+	raisedLock.Lock();
+	if debugError == nil {
+		debugError = err;
+	}
+	raisedLock.Unlock();
+	DebugReport(debugMode, err, args...);
+}
+
+// takeDebugError() returns and clears debugError.
+func takeDebugError() error {
+	raisedLock.Lock();
+	err := debugError;
+	debugError = nil;
+	raisedLock.Unlock();
+	return err;
+}
+
+// DebugReport() logs or panics with err as mode says,
+// blaming it on the call a client made into the package
+// that called DebugReport(). This is the debug hook for
+// packages built on top of this one, like openal/alc and
+// openal/efx, so their debug messages look just like ours.
+func DebugReport(mode int, err error, args ...interface{}) {
+	name, where := debugCaller();
+	err = fmt.Errorf("%s(%s) at %s: %w", name, debugArgs(args), where, err);
+	switch mode {
+	case DebugLog:
+		log.Print(err);
+	case DebugPanic:
+		panic(err);
+	}
+}
+
+// debugCaller() walks up the stack from the package that
+// called DebugReport() to the first frame outside of it.
+// The frame right before that is the call a client made
+// into the package.
+func debugCaller() (name, where string) {
+	pcs := make([]uintptr, 32);
+	n := runtime.Callers(3, pcs); // skip Callers, debugCaller, DebugReport
+	frames := runtime.CallersFrames(pcs[0:n]);
+	frame, more := frames.Next();
+	prefix := debugPackage(frame.Function);
+	name = "?";
+	where = "?";
+	for more {
+		frame, more = frames.Next();
+		if !strings.HasPrefix(frame.Function, prefix) {
+			where = fmt.Sprintf("%s:%d", frame.File, frame.Line);
+			break;
+		}
+		name = frame.Function[strings.LastIndex(prefix[0:len(prefix)-1], "/")+1:];
+	}
+	return;
+}
+
+// debugPackage() returns the package part of a function
+// name including the dot, for example "openal/alc." for
+// "openal/alc.(*Device).GetError".
+func debugPackage(function string) string {
+	slash := strings.LastIndex(function, "/") + 1;
+	dot := strings.Index(function[slash:], ".");
+	if dot < 0 {
+		return function;
+	}
+	return function[0:slash+dot+1];
+}
+
+// debugArgs() formats arguments for debug messages, but
+// doesn't dump slices (sample data, mostly) in their
+// entirety.
+func debugArgs(args []interface{}) string {
+	s := make([]string, len(args));
+	for i, arg := range args {
+		v := reflect.ValueOf(arg);
+		switch {
+		case v.Kind() != reflect.Slice:
+			s[i] = fmt.Sprintf("%v", arg);
+		case v.Type().Elem().Kind() == reflect.Uint8:
+			s[i] = fmt.Sprintf("[%d bytes]", v.Len());
+		default:
+			s[i] = fmt.Sprintf("[%d elements]", v.Len());
+		}
+	}
+	return strings.Join(s, ", ");
+}
@@ -0,0 +1,29 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package al
+
+import "testing"
+
+// openal/alc and openal/efx report through DebugReport()
+// as well, so these cover their slices too.
+var debugArgsTests = []struct {
+	args []interface{};
+	out string;
+}{
+	{[]interface{}{}, ""},
+	{[]interface{}{Source(3), int32(0x1007), true}, "3, 4103, true"},
+	{[]interface{}{make([]byte, 4096)}, "[4096 bytes]"},
+	{[]interface{}{make([]int16, 1024), make([]float32, 6)}, "[1024 elements], [6 elements]"},
+	{[]interface{}{[]Source{1, 2, 3}, []Buffer{}}, "[3 elements], [0 elements]"},
+	{[]interface{}{Vector{1, 2, 3}}, "[1 2 3]"},
+}
+
+func TestDebugArgs(t *testing.T) {
+	for _, tt := range debugArgsTests {
+		if out := debugArgs(tt.args); out != tt.out {
+			t.Errorf("debugArgs(%d args) = %q; want %q", len(tt.args), out, tt.out);
+		}
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Decoders for compressed buffer formats, in pure Go.
+//
+// Buffer.SetData() falls back on these when OpenAL doesn't
+// support a compressed format itself, so the samples end up
+// in the buffer as plain 16 bit PCM instead. The formats are
+// laid out exactly as OpenAL Soft expects them.
+
+package al
+
+// compressedFormat describes a compressed buffer format: the
+// extension that provides it, how many channels it has,
+// whether it comes in blocks, and how to decode it. Block
+// alignments are in sample frames, 0 meaning the default.
+// Decoders return ErrInvalidValue for data OpenAL would
+// reject as well: bad alignments and partial blocks.
+type compressedFormat struct {
+	extension string;
+	channels int;
+	blocks bool;
+	decode func(data []byte, channels int, align int) ([]int16, error);
+}
+
+var compressedFormats = map[int32]compressedFormat{
+	FormatMonoMulaw: {"AL_EXT_MULAW", 1, false, decodeMulaw},
+	FormatStereoMulaw: {"AL_EXT_MULAW", 2, false, decodeMulaw},
+	FormatMonoAlaw: {"AL_EXT_ALAW", 1, false, decodeAlaw},
+	FormatStereoAlaw: {"AL_EXT_ALAW", 2, false, decodeAlaw},
+	FormatMonoIMA4: {"AL_EXT_IMA4", 1, true, decodeIMA4},
+	FormatStereoIMA4: {"AL_EXT_IMA4", 2, true, decodeIMA4},
+	FormatMonoMSADPCM: {"AL_SOFT_MSADPCM", 1, true, decodeMSADPCM},
+	FormatStereoMSADPCM: {"AL_SOFT_MSADPCM", 2, true, decodeMSADPCM},
+}
+
+///// mu-law and A-law ///////////////////////////////////////////////
+
+func decodeMulaw(data []byte, channels int, align int) ([]int16, error) {
+	if len(data) % channels != 0 {
+		return nil, ErrInvalidValue;
+	}
+	samples := make([]int16, len(data));
+	for i, b := range data {
+		b = ^b;
+		exponent := uint(b >> 4) & 7;
+		mantissa := int(b & 0x0F);
+		s := ((mantissa << 3) + 0x84) << exponent - 0x84;
+		if b & 0x80 != 0 {
+			s = -s;
+		}
+		samples[i] = int16(s);
+	}
+	return samples, nil;
+}
+
+func decodeAlaw(data []byte, channels int, align int) ([]int16, error) {
+	if len(data) % channels != 0 {
+		return nil, ErrInvalidValue;
+	}
+	samples := make([]int16, len(data));
+	for i, b := range data {
+		b ^= 0x55;
+		exponent := uint(b >> 4) & 7;
+		mantissa := int(b & 0x0F);
+		var s int;
+		if exponent == 0 {
+			s = mantissa << 4 + 8;
+		} else {
+			s = (mantissa << 4 + 0x108) << (exponent - 1);
+		}
+		if b & 0x80 == 0 {
+			s = -s;
+		}
+		samples[i] = int16(s);
+	}
+	return samples, nil;
+}
+
+///// IMA4 ///////////////////////////////////////////////////////////
+
+var imaSteps = [89]int{
+	7, 8, 9, 10, 11, 12, 13, 14, 16, 17, 19, 21, 23, 25, 28, 31,
+	34, 37, 41, 45, 50, 55, 60, 66, 73, 80, 88, 97, 107, 118, 130, 143,
+	157, 173, 190, 209, 230, 253, 279, 307, 337, 371, 408, 449, 494, 544, 598, 658,
+	724, 796, 876, 963, 1060, 1166, 1282, 1411, 1552, 1707, 1878, 2066, 2272, 2499, 2749, 3024,
+	3327, 3660, 4026, 4428, 4871, 5358, 5894, 6484, 7132, 7845, 8630, 9493, 10442, 11487, 12635, 13899,
+	15289, 16818, 18500, 20350, 22385, 24623, 27086, 29794, 32767,
+}
+
+var imaIndices = [8]int{-1, -1, -1, -1, 2, 4, 6, 8};
+
+func clamp16(s int) int {
+	switch {
+	case s < -32768:
+		return -32768;
+	case s > 32767:
+		return 32767;
+	}
+	return s;
+}
+
+// decodeIMA4() decodes blocks of align sample frames. Each
+// block starts with a 4 byte header per channel (the first
+// sample and the step index), followed by groups of 4 bytes
+// per channel holding 8 samples each, low nibble first.
+func decodeIMA4(data []byte, channels int, align int) ([]int16, error) {
+	if align == 0 {
+		align = 65;
+	}
+	if align <= 0 || (align-1) % 8 != 0 {
+		return nil, ErrInvalidValue;
+	}
+	blockSize := channels * (4 + (align-1) / 2);
+	if len(data) % blockSize != 0 {
+		return nil, ErrInvalidValue;
+	}
+	var samples []int16;
+	for ; len(data) >= blockSize; data = data[blockSize:] {
+		block := make([]int16, align * channels);
+		sample := make([]int, channels);
+		index := make([]int, channels);
+		for c := 0; c < channels; c++ {
+			h := data[c*4:];
+			sample[c] = int(int16(uint16(h[0]) | uint16(h[1]) << 8));
+			index[c] = int(h[2]);
+			if index[c] > 88 {
+				index[c] = 88;
+			}
+			block[c] = int16(sample[c]);
+		}
+		p := channels * 4;
+		for f := 1; f < align; f += 8 {
+			for c := 0; c < channels; c++ {
+				for k := 0; k < 8 && f+k < align; k++ {
+					n := int(data[p + k/2] >> (uint(k%2) * 4)) & 0x0F;
+					step := imaSteps[index[c]];
+					diff := step >> 3;
+					if n & 4 != 0 {
+						diff += step;
+					}
+					if n & 2 != 0 {
+						diff += step >> 1;
+					}
+					if n & 1 != 0 {
+						diff += step >> 2;
+					}
+					if n & 8 != 0 {
+						sample[c] = clamp16(sample[c] - diff);
+					} else {
+						sample[c] = clamp16(sample[c] + diff);
+					}
+					index[c] += imaIndices[n & 7];
+					if index[c] < 0 {
+						index[c] = 0;
+					} else if index[c] > 88 {
+						index[c] = 88;
+					}
+					block[(f+k)*channels + c] = int16(sample[c]);
+				}
+				p += 4;
+			}
+		}
+		samples = append(samples, block...);
+	}
+	return samples, nil;
+}
+
+///// MS ADPCM ///////////////////////////////////////////////////////
+
+var msadpcmCoefficients = [7][2]int{
+	{256, 0}, {512, -256}, {0, 0}, {192, 64}, {240, 0}, {460, -208}, {392, -232},
+}
+
+var msadpcmAdaption = [16]int{
+	230, 230, 230, 230, 307, 409, 512, 614, 768, 614, 512, 409, 307, 230, 230, 230,
+}
+
+// decodeMSADPCM() decodes blocks of align sample frames.
+// Each block starts with a 7 byte header per channel (the
+// predictor, the initial delta, and the first two samples
+// in reverse order), followed by one nibble per sample,
+// high nibble first, with the channels interleaved.
+func decodeMSADPCM(data []byte, channels int, align int) ([]int16, error) {
+	if align == 0 {
+		align = 64;
+	}
+	if align < 2 || align % 2 != 0 {
+		return nil, ErrInvalidValue;
+	}
+	blockSize := channels * (7 + (align-2) / 2);
+	if len(data) % blockSize != 0 {
+		return nil, ErrInvalidValue;
+	}
+	var samples []int16;
+	word := func(b []byte, i int) int {
+		return int(int16(uint16(b[2*i]) | uint16(b[2*i+1]) << 8));
+	};
+	for ; len(data) >= blockSize; data = data[blockSize:] {
+		block := make([]int16, align * channels);
+		coef := make([][2]int, channels);
+		delta := make([]int, channels);
+		s1 := make([]int, channels);
+		s2 := make([]int, channels);
+		h := data[channels:];
+		for c := 0; c < channels; c++ {
+			predictor := int(data[c]);
+			if predictor > 6 {
+				predictor = 6;
+			}
+			coef[c] = msadpcmCoefficients[predictor];
+			delta[c] = word(h, c);
+			s1[c] = word(h, channels + c);
+			s2[c] = word(h, 2*channels + c);
+			block[c] = int16(s2[c]);
+			block[channels + c] = int16(s1[c]);
+		}
+		nibbles := data[channels*7:blockSize];
+		for i := 0; i < (align-2) * channels; i++ {
+			c := i % channels;
+			n := int(nibbles[i/2] >> (uint(1 - i%2) * 4)) & 0x0F;
+			signed := n;
+			if signed >= 8 {
+				signed -= 16;
+			}
+			pred := (s1[c]*coef[c][0] + s2[c]*coef[c][1]) >> 8;
+			pred = clamp16(pred + signed*delta[c]);
+			s2[c] = s1[c];
+			s1[c] = pred;
+			delta[c] = msadpcmAdaption[n] * delta[c] >> 8;
+			if delta[c] < 16 {
+				delta[c] = 16;
+			}
+			block[2*channels + i] = int16(pred);
+		}
+		samples = append(samples, block...);
+	}
+	return samples, nil;
+}
@@ -0,0 +1,136 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package al
+
+import "testing"
+
+var lawTests = []struct {
+	name string;
+	decode func(data []byte, channels int, align int) ([]int16, error);
+	in byte;
+	out int16;
+}{
+	{"mulaw", decodeMulaw, 0xFF, 0},
+	{"mulaw", decodeMulaw, 0x7F, 0},
+	{"mulaw", decodeMulaw, 0x00, -32124},
+	{"mulaw", decodeMulaw, 0x80, 32124},
+	{"alaw", decodeAlaw, 0xD5, 8},
+	{"alaw", decodeAlaw, 0x55, -8},
+	{"alaw", decodeAlaw, 0xAA, 32256},
+	{"alaw", decodeAlaw, 0x2A, -32256},
+}
+
+func TestDecodeLaw(t *testing.T) {
+	for _, tt := range lawTests {
+		samples, err := tt.decode([]byte{tt.in}, 1, 0);
+		if err != nil || len(samples) != 1 || samples[0] != tt.out {
+			t.Errorf("%s(0x%02X) = %v, %v; want [%d]", tt.name, tt.in, samples, err, tt.out);
+		}
+	}
+}
+
+var blockTests = []struct {
+	name string;
+	decode func(data []byte, channels int, align int) ([]int16, error);
+	size int; // bytes of data
+	channels int;
+	align int;
+	frames int; // 0 means we expect ErrInvalidValue
+}{
+	{"mulaw stereo", decodeMulaw, 4, 2, 0, 2},
+	{"mulaw stereo partial frame", decodeMulaw, 3, 2, 0, 0},
+	{"alaw stereo partial frame", decodeAlaw, 5, 2, 0, 0},
+	{"ima4 mono default", decodeIMA4, 36, 1, 0, 65},
+	{"ima4 stereo default", decodeIMA4, 72, 2, 0, 65},
+	{"ima4 mono two blocks", decodeIMA4, 72, 1, 0, 130},
+	{"ima4 mono align 9", decodeIMA4, 8, 1, 9, 9},
+	{"ima4 mono align 1", decodeIMA4, 4, 1, 1, 1},
+	{"ima4 stereo align 5", decodeIMA4, 12, 2, 5, 0},
+	{"ima4 mono align 64", decodeIMA4, 36, 1, 64, 0},
+	{"ima4 mono negative align", decodeIMA4, 36, 1, -7, 0},
+	{"ima4 mono partial block", decodeIMA4, 35, 1, 0, 0},
+	{"msadpcm mono default", decodeMSADPCM, 38, 1, 0, 64},
+	{"msadpcm stereo default", decodeMSADPCM, 76, 2, 0, 64},
+	{"msadpcm mono align 2", decodeMSADPCM, 7, 1, 2, 2},
+	{"msadpcm mono align 5", decodeMSADPCM, 8, 1, 5, 0},
+	{"msadpcm mono negative align", decodeMSADPCM, 38, 1, -64, 0},
+	{"msadpcm stereo partial block", decodeMSADPCM, 75, 2, 0, 0},
+}
+
+func TestDecodeBlocks(t *testing.T) {
+	for _, tt := range blockTests {
+		samples, err := tt.decode(make([]byte, tt.size), tt.channels, tt.align);
+		if tt.frames == 0 {
+			if err != ErrInvalidValue {
+				t.Errorf("%s: got %d samples, %v; want ErrInvalidValue", tt.name, len(samples), err);
+			}
+			continue;
+		}
+		if err != nil || len(samples) != tt.frames * tt.channels {
+			t.Errorf("%s: got %d samples, %v; want %d", tt.name, len(samples), err, tt.frames * tt.channels);
+		}
+	}
+}
+
+// Hand-built blocks with the samples they must decode to,
+// worked out from the IMA ADPCM and Microsoft ADPCM specs
+// rather than with the decoders themselves.
+var vectorTests = []struct {
+	name string;
+	decode func(data []byte, channels int, align int) ([]int16, error);
+	channels int;
+	align int;
+	in []byte;
+	out []int16;
+}{
+	{"ima4 mono", decodeIMA4, 1, 9,
+		[]byte{
+			0x64, 0x00, 0x00, 0x00, // sample 100, step index 0
+			0x73, 0x08, 0x1F, 0x00, // nibbles 3 7 8 0 15 1 0 0
+		},
+		[]int16{100, 104, 115, 113, 114, 91, 101, 104, 106}},
+	{"ima4 stereo", decodeIMA4, 2, 9,
+		[]byte{
+			0x64, 0x00, 0x00, 0x00, // left: sample 100, step index 0
+			0x38, 0xFF, 0x0A, 0x00, // right: sample -200, step index 10
+			0x73, 0x08, 0x1F, 0x00, // left: nibbles 3 7 8 0 15 1 0 0
+			0x04, 0x00, 0x00, 0x00, // right: nibbles 4 0 0 0 0 0 0 0
+		},
+		[]int16{100, -200, 104, -179, 115, -177, 113, -175, 114, -173, 91, -171, 101, -169, 104, -168, 106, -167}},
+	{"msadpcm mono", decodeMSADPCM, 1, 4,
+		[]byte{
+			0x01, // predictor 1
+			0x10, 0x00, // delta 16
+			0x64, 0x00, // sample 1: 100
+			0x32, 0x00, // sample 2: 50
+			0x3E, // nibbles 3 -2
+		},
+		[]int16{50, 100, 198, 264}},
+	{"msadpcm stereo", decodeMSADPCM, 2, 4,
+		[]byte{
+			0x01, 0x00, // predictors 1 and 0
+			0x10, 0x00, 0x20, 0x00, // deltas 16 and 32
+			0x64, 0x00, 0xF6, 0xFF, // sample 1: 100 and -10
+			0x32, 0x00, 0x00, 0x00, // sample 2: 50 and 0
+			0x31, 0xE7, // nibbles left 3 -2, right 1 7
+		},
+		[]int16{50, 0, 100, -10, 198, 22, 264, 218}},
+}
+
+func TestDecodeVectors(t *testing.T) {
+	for _, tt := range vectorTests {
+		samples, err := tt.decode(tt.in, tt.channels, tt.align);
+		if err != nil || len(samples) != len(tt.out) {
+			t.Errorf("%s: got %v, %v; want %v", tt.name, samples, err, tt.out);
+			continue;
+		}
+		for i := range samples {
+			if samples[i] != tt.out[i] {
+				t.Errorf("%s: got %v; want %v", tt.name, samples, tt.out);
+				break;
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Error values in pure Go.
+//
+// OpenAL reports errors as plain enum values that have
+// to be polled with GetError(). We wrap those values in
+// the Error type so they can travel through the usual
+// Go error handling machinery.
+
+package al
+
+import "fmt"
+
+// Error is an error code generated by the AL state machine.
+type Error int32;
+
+// Sentinel errors for comparisons with errors.Is(). They
+// are simply the error codes from GetError() as Errors.
+var (
+	ErrInvalidName error = Error(InvalidName);
+	ErrInvalidEnum error = Error(InvalidEnum);
+	ErrInvalidValue error = Error(InvalidValue);
+	ErrInvalidOperation error = Error(InvalidOperation);
+	ErrOutOfMemory error = Error(OutOfMemory);
+)
+
+var errorNames = map[Error]string{
+	NoError: "NoError",
+	InvalidName: "InvalidName",
+	InvalidEnum: "InvalidEnum",
+	InvalidValue: "InvalidValue",
+	InvalidOperation: "InvalidOperation",
+	OutOfMemory: "OutOfMemory",
+}
+
+// String() returns the Go name of the error code, for
+// example "InvalidValue" for InvalidValue.
+func (self Error) String() string {
+	name, ok := errorNames[self];
+	if !ok {
+		return fmt.Sprintf("Error(0x%x)", int32(self));
+	}
+	return name;
+}
+
+// Error() makes Error an error.
+func (self Error) Error() string {
+	return "al: " + self.String();
+}
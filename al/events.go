@@ -0,0 +1,250 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package al
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include "wrapper.h"
+*/
+import "C"
+import "runtime"
+import "sync"
+import "unsafe"
+
+// EventType says what happened, see Event.
+type EventType int32
+
+// Event types for the AL_SOFT_events extension.
+const (
+	BufferCompleted EventType = 0x19A4;
+	SourceStateChanged EventType = 0x19A5;
+	Disconnected EventType = 0x19A6;
+)
+
+var eventTypeNames = map[EventType]string{
+	BufferCompleted: "buffer completed",
+	SourceStateChanged: "source state changed",
+	Disconnected: "disconnected",
+}
+
+func (self EventType) String() string {
+	if name, ok := eventTypeNames[self]; ok {
+		return name;
+	}
+	return "unknown event";
+}
+
+// Event is a notification from OpenAL about the current
+// context. For BufferCompleted, Param is the number of
+// buffers the Source has finished playing from its queue;
+// for SourceStateChanged, Param is the new State() of the
+// Source (Stopped once a one-shot is done, for example).
+// Disconnected means the device is gone, see
+// alc.Device.Connected(). The Message is for humans.
+type Event struct {
+	Type EventType;
+	Source Source;
+	Param int32;
+	Message string;
+}
+
+// subscription is one channel or function registered with
+// Notify() or NotifyFunc().
+type subscription struct {
+	id int;
+	context unsafe.Pointer; // the ALCcontext it's for
+	c chan<- Event;
+	f func(Event);
+	types []EventType;
+}
+
+// Two locks keep us from deadlocking with OpenAL, which
+// holds its own event lock while it calls walHandleEvent().
+// eventLock guards the subscriptions and is never held
+// while we call into OpenAL; controlLock serializes our
+// calls into OpenAL and is never taken by walHandleEvent().
+var (
+	eventLock sync.Mutex;
+	subscriptions []subscription;
+	lastSubscription int;
+	controlLock sync.Mutex;
+)
+
+var allEventTypes = []EventType{BufferCompleted, SourceStateChanged, Disconnected};
+
+// Notify() makes the package send events of the given
+// types to c, or events of all types if none are given.
+// Events are dropped rather than block OpenAL, so c
+// should be buffered.
+//
+// Events are per context: c only gets events from the
+// context that is current when Notify() is called, so make
+// the context current first. Needs AL_SOFT_events, check
+// IsExtensionPresent("AL_SOFT_events").
+func Notify(c chan<- Event, types ...EventType) {
+	subscribe(subscription{c: c, types: types});
+}
+
+// NotifyFunc() is like Notify() but calls f for each event
+// instead. OpenAL calls f from one of its own threads, so
+// f must be quick and must not call back into OpenAL.
+// Calling the returned function stops the notifications.
+func NotifyFunc(f func(Event), types ...EventType) (stop func()) {
+	id := subscribe(subscription{f: f, types: types});
+	return func() {
+		unsubscribe(func(s subscription) bool { return s.id == id });
+	};
+}
+
+// StopNotify() makes the package stop sending events to c.
+func StopNotify(c chan<- Event) {
+	unsubscribe(func(s subscription) bool { return s.c != nil && s.c == c });
+}
+
+func subscribe(s subscription) int {
+	if len(s.types) == 0 {
+		s.types = allEventTypes;
+	}
+	s.context = C.walCurrentContext();
+	eventLock.Lock();
+	lastSubscription++;
+	s.id = lastSubscription;
+	subscriptions = append(subscriptions[0:len(subscriptions):len(subscriptions)], s);
+	eventLock.Unlock();
+	updateEvents(s.context, true);
+	return s.id;
+}
+
+func unsubscribe(match func(subscription) bool) {
+	eventLock.Lock();
+	var kept []subscription;
+	contexts := make(map[unsafe.Pointer]bool);
+	for _, s := range subscriptions {
+		if match(s) {
+			contexts[s.context] = true;
+		} else {
+			kept = append(kept, s);
+		}
+	}
+	subscriptions = kept;
+	eventLock.Unlock();
+	for context := range contexts {
+		updateEvents(context, false);
+	}
+}
+
+// forgetSubscriptions() drops the subscriptions for a
+// context that is going away, see ForgetContext().
+func forgetSubscriptions(context unsafe.Pointer) {
+	eventLock.Lock();
+	var kept []subscription;
+	for _, s := range subscriptions {
+		if s.context != context {
+			kept = append(kept, s);
+		}
+	}
+	subscriptions = kept;
+	eventLock.Unlock();
+}
+
+// updateEvents() turns event types on or off for a context
+// so we only get what somebody is waiting for, installing
+// our callback first if asked to. Must be called without
+// eventLock held, see above. If the context isn't current
+// we need ALC_EXT_thread_local_context to get at it;
+// without that we leave its events alone, walHandleEvent()
+// still only passes on what somebody is waiting for.
+func updateEvents(context unsafe.Pointer, install bool) {
+	if context == nil {
+		return;
+	}
+	controlLock.Lock();
+	defer controlLock.Unlock();
+	wanted := make(map[EventType]bool);
+	eventLock.Lock();
+	for _, s := range subscriptions {
+		if s.context != context {
+			continue;
+		}
+		for _, t := range s.types {
+			wanted[t] = true;
+		}
+	}
+	eventLock.Unlock();
+	onContext(context, func() {
+		if install {
+			C.walEventCallbackSOFT(context);
+		}
+		var on, off []int32;
+		for _, t := range allEventTypes {
+			if wanted[t] {
+				on = append(on, int32(t));
+			} else {
+				off = append(off, int32(t));
+			}
+		}
+		if len(on) > 0 {
+			C.walEventControlSOFT(C.ALsizei(len(on)), unsafe.Pointer(&on[0]), alTrue);
+		}
+		if len(off) > 0 {
+			C.walEventControlSOFT(C.ALsizei(len(off)), unsafe.Pointer(&off[0]), alFalse);
+		}
+		if debugMode != DebugOff {
+			debugCheck(on, off);
+		}
+	});
+}
+
+// onContext() runs f with the given context current. If
+// it isn't current already, we make it current for this
+// thread only, see ALC_EXT_thread_local_context; without
+// that extension f doesn't run and we return false.
+func onContext(context unsafe.Pointer, f func()) bool {
+	if C.walCurrentContext() == context {
+		f();
+		return true;
+	}
+	runtime.LockOSThread();
+	defer runtime.UnlockOSThread();
+	var previous unsafe.Pointer;
+	if C.walSwapThreadContext(context, &previous) == alFalse {
+		return false;
+	}
+	f();
+	C.walSwapThreadContext(previous, &previous);
+	return true;
+}
+
+// walHandleEvent() runs on an OpenAL thread with OpenAL's
+// event lock held, so it only takes eventLock long enough
+// to grab the current subscriptions. Events only go to
+// subscriptions for the context they came from.
+//export walHandleEvent
+func walHandleEvent(eventType C.ALenum, object C.ALuint, param C.ALuint, length C.ALsizei, message *C.char, context unsafe.Pointer) {
+	e := Event{EventType(eventType), Source(object), int32(param), C.GoStringN(message, C.int(length))};
+	eventLock.Lock();
+	current := subscriptions;
+	eventLock.Unlock();
+	for _, s := range current {
+		if s.context != context {
+			continue;
+		}
+		for _, t := range s.types {
+			if t != e.Type {
+				continue;
+			}
+			if s.f != nil {
+				s.f(e);
+			} else {
+				select {
+				case s.c <- e:
+				default:
+				}
+			}
+			break;
+		}
+	}
+}
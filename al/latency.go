@@ -0,0 +1,116 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package al
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include "wrapper.h"
+*/
+import "C"
+import "time"
+import "unsafe"
+
+// AL_SOFT_source_latency extension. Check
+// IsExtensionPresent("AL_SOFT_source_latency") first,
+// without it all of these are zero. Scheduled playback
+// (AL_SOFT_source_start_delay) is at the end of the file
+// since it's timed by the same device clock.
+const (
+	alSampleOffsetLatency = 0x1200;
+	alSecOffsetLatency = 0x1201;
+	alSampleOffsetClock = 0x1202;
+	alSecOffsetClock = 0x1203;
+)
+
+// Renamed, was GetSourcedvSOFT.
+func (self Source) getdv(param int32, values []float64) {
+	C.walGetSourcedvSOFT(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
+}
+
+// Renamed, was GetSourcei64vSOFT.
+func (self Source) geti64v(param int32, values []int64) {
+	C.walGetSourcei64vSOFT(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second));
+}
+
+// OffsetLatency() returns the playback position of the
+// source together with how long it will take until the
+// sound at that position actually leaves the speakers.
+// Both are read at the same time, so offset + latency is
+// the position we can hear right now, give or take.
+func (self Source) OffsetLatency() (offset, latency time.Duration) {
+	values := make([]float64, 2);
+	self.getdv(alSecOffsetLatency, values);
+	return seconds(values[0]), seconds(values[1]);
+}
+
+// OffsetClock() returns the playback position of the
+// source together with the device clock at that moment,
+// see alc.Device.Clock().
+func (self Source) OffsetClock() (offset, clock time.Duration) {
+	values := make([]float64, 2);
+	self.getdv(alSecOffsetClock, values);
+	return seconds(values[0]), seconds(values[1]);
+}
+
+// OffsetSamplesLatency() is like OffsetLatency() but
+// returns the offset in sample frames, as a 32.32 fixed
+// point number to keep the fractional part. Shift right
+// by 32 for whole frames.
+func (self Source) OffsetSamplesLatency() (offset int64, latency time.Duration) {
+	values := make([]int64, 2);
+	self.geti64v(alSampleOffsetLatency, values);
+	return values[0], time.Duration(values[1]);
+}
+
+// OffsetSamplesClock() is like OffsetClock() but returns
+// the offset in sample frames, see OffsetSamplesLatency().
+func (self Source) OffsetSamplesClock() (offset int64, clock time.Duration) {
+	values := make([]int64, 2);
+	self.geti64v(alSampleOffsetClock, values);
+	return values[0], time.Duration(values[1]);
+}
+
+// PlayAtTime() starts the source once the device clock
+// (see alc.Device.Clock()) reaches the given time, exactly
+// on that sample. If the time has passed already the
+// source starts right away. Needs AL_SOFT_source_start_delay;
+// without it the source doesn't start and we report
+// InvalidOperation, Play() it instead if timing doesn't
+// matter.
+// Renamed, was SourcePlayAtTimeSOFT.
+func (self Source) PlayAtTime(clock time.Duration) {
+	if C.walSourcePlayAtTimeSOFT(C.ALuint(self), C.longlong(clock)) == alFalse {
+		raise(InvalidOperation);
+	}
+	if debugMode != DebugOff {
+		debugCheck(self, clock);
+	}
+}
+
+// PlaySourcesAtTime() is like PlayAtTime() for several
+// sources, which all start on the very same sample.
+// Renamed, was SourcePlayAtTimevSOFT.
+func PlaySourcesAtTime(sources []Source, clock time.Duration) {
+	if len(sources) == 0 {
+		return;
+	}
+	if C.walSourcePlayAtTimevSOFT(C.ALsizei(len(sources)), unsafe.Pointer(&sources[0]), C.longlong(clock)) == alFalse {
+		raise(InvalidOperation);
+	}
+	if debugMode != DebugOff {
+		debugCheck(sources, clock);
+	}
+}
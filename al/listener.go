@@ -30,36 +30,58 @@ type Listener struct{};
 // Renamed, was Listenerf.
 func (self Listener) setf(param int32, value float32) {
 	C.alListenerf(C.ALenum(param), C.ALfloat(value));
+	if debugMode != DebugOff {
+		debugCheck(self, param, value);
+	}
 }
 
 // Renamed, was Listener3f.
 func (self Listener) set3f(param int32, value1, value2, value3 float32) {
 	C.alListener3f(C.ALenum(param), C.ALfloat(value1), C.ALfloat(value2), C.ALfloat(value3));
+	if debugMode != DebugOff {
+		debugCheck(self, param, value1, value2, value3);
+	}
 }
 
 // Renamed, was Listenerfv.
 func (self Listener) setfv(param int32, values []float32) {
 	C.walListenerfv(C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, values);
+	}
 }
 
 // Renamed, was Listeneri.
 func (self Listener) seti(param int32, value int32) {
 	C.alListeneri(C.ALenum(param), C.ALint(value));
+	if debugMode != DebugOff {
+		debugCheck(self, param, value);
+	}
 }
 
 // Renamed, was Listener3i.
 func (self Listener) set3i(param int32, value1, value2, value3 int32) {
 	C.alListener3i(C.ALenum(param), C.ALint(value1), C.ALint(value2), C.ALint(value3));
+	if debugMode != DebugOff {
+		debugCheck(self, param, value1, value2, value3);
+	}
 }
 
 // Renamed, was Listeneriv.
 func (self Listener) setiv(param int32, values []int32) {
 	C.walListeneriv(C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, values);
+	}
 }
 
 // Renamed, was GetListenerf.
 func (self Listener) getf(param int32) float32 {
-	return float32(C.walGetListenerf(C.ALenum(param)));
+	result := float32(C.walGetListenerf(C.ALenum(param)));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
+	return result;
 }
 
 // Renamed, was GetListener3f.
@@ -67,6 +89,9 @@ func (self Listener) get3f(param int32) (value1, value2, value3 float32) {
 	var v1, v2, v3 float32;
 	C.walGetListener3f(C.ALenum(param), unsafe.Pointer(&v1),
 		unsafe.Pointer(&v2), unsafe.Pointer(&v3));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
 	value1, value2, value3 = v1, v2, v3;
 	return;
 }
@@ -74,12 +99,19 @@ func (self Listener) get3f(param int32) (value1, value2, value3 float32) {
 // Renamed, was GetListenerfv.
 func (self Listener) getfv(param int32, values []float32) {
 	C.walGetListenerfv(C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, values);
+	}
 	return;
 }
 
 // Renamed, was GetListeneri.
 func (self Listener) geti(param int32) int32 {
-	return int32(C.walGetListeneri(C.ALenum(param)));
+	result := int32(C.walGetListeneri(C.ALenum(param)));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
+	return result;
 }
 
 // Renamed, was GetListener3i.
@@ -87,6 +119,9 @@ func (self Listener) get3i(param int32) (value1, value2, value3 int32) {
 	var v1, v2, v3 int32;
 	C.walGetListener3i(C.ALenum(param), unsafe.Pointer(&v1),
 		unsafe.Pointer(&v2), unsafe.Pointer(&v3));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
 	value1, value2, value3 = v1, v2, v3;
 	return;
 }
@@ -94,6 +129,9 @@ func (self Listener) get3i(param int32) (value1, value2, value3 int32) {
 // Renamed, was GetListeneriv.
 func (self Listener) getiv(param int32, values []int32) {
 	C.walGetListeneriv(C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, values);
+	}
 }
 
 ///// Convenience ////////////////////////////////////////////////////
@@ -10,6 +10,8 @@ package al
 #include "wrapper.h"
 */
 import "C"
+import "sort"
+import "sync"
 import "unsafe"
 
 // Results from Source.State() query.
@@ -57,69 +59,170 @@ type Source uint32;
 // Renamed, was GenSources.
 func NewSources(n int) (sources []Source) {
 	sources = make([]Source, n);
-	C.walGenSources(C.ALsizei(n), unsafe.Pointer(&sources[0]));
+	if succeeded(func() { C.walGenSources(C.ALsizei(n), unsafe.Pointer(&sources[0])) }) {
+		trackSources(sources);
+	}
+	if debugMode != DebugOff {
+		debugCheck(n);
+	}
 	return;
 }
 
 // DeleteSources() deletes the given sources.
 func DeleteSources(sources []Source) {
 	n := len(sources);
-	C.walDeleteSources(C.ALsizei(n), unsafe.Pointer(&sources[0]));
+	if succeeded(func() { C.walDeleteSources(C.ALsizei(n), unsafe.Pointer(&sources[0])) }) {
+		untrackSources(sources);
+	}
+	if debugMode != DebugOff {
+		debugCheck(sources);
+	}
+}
+
+// The sources NewSource() and NewSources() created and that
+// weren't deleted since, by the context they belong to, see
+// Sources(). OpenAL itself has no way to list them.
+var (
+	sourceLock sync.Mutex;
+	liveSources = make(map[uintptr]map[Source]bool);
+)
+
+func trackSources(sources []Source) {
+	context := uintptr(C.walCurrentContext());
+	sourceLock.Lock();
+	live := liveSources[context];
+	if live == nil {
+		live = make(map[Source]bool);
+		liveSources[context] = live;
+	}
+	for _, s := range sources {
+		live[s] = true;
+	}
+	sourceLock.Unlock();
+}
+
+func untrackSources(sources []Source) {
+	context := uintptr(C.walCurrentContext());
+	sourceLock.Lock();
+	for _, s := range sources {
+		delete(liveSources[context], s);
+	}
+	sourceLock.Unlock();
+}
+
+// Sources() returns all sources of the current context,
+// ordered by name. Only sources created and deleted
+// through this package count.
+func Sources() []Source {
+	context := uintptr(C.walCurrentContext());
+	sourceLock.Lock();
+	sources := make([]Source, 0, len(liveSources[context]));
+	for s := range liveSources[context] {
+		sources = append(sources, s);
+	}
+	sourceLock.Unlock();
+	sort.Slice(sources, func(i, j int) bool { return sources[i] < sources[j] });
+	return sources;
+}
+
+// ForgetContext() drops what the package knows about a
+// context, see Sources() and Notify(). The context is an
+// ALCcontext pointer, openal/alc calls this when it
+// destroys one.
+func ForgetContext(context unsafe.Pointer) {
+	sourceLock.Lock();
+	delete(liveSources, uintptr(context));
+	sourceLock.Unlock();
+	forgetSubscriptions(context);
 }
 
 // Renamed, was SourcePlayv.
 func PlaySources(sources []Source) {
 	C.walSourcePlayv(C.ALsizei(len(sources)), unsafe.Pointer(&sources[0]));
+	if debugMode != DebugOff {
+		debugCheck(sources);
+	}
 }
 
 // Renamed, was SourceStopv.
 func StopSources(sources []Source) {
 	C.walSourceStopv(C.ALsizei(len(sources)), unsafe.Pointer(&sources[0]));
+	if debugMode != DebugOff {
+		debugCheck(sources);
+	}
 }
 
 // Renamed, was SourceRewindv.
 func RewindSources(sources []Source) {
 	C.walSourceRewindv(C.ALsizei(len(sources)), unsafe.Pointer(&sources[0]));
+	if debugMode != DebugOff {
+		debugCheck(sources);
+	}
 }
 
 // Renamed, was SourcePausev.
 func PauseSources(sources []Source) {
 	C.walSourcePausev(C.ALsizei(len(sources)), unsafe.Pointer(&sources[0]));
+	if debugMode != DebugOff {
+		debugCheck(sources);
+	}
 }
 
 // Renamed, was Sourcef.
 func (self Source) setf(param int32, value float32) {
 	C.alSourcef(C.ALuint(self), C.ALenum(param), C.ALfloat(value));
+	if debugMode != DebugOff {
+		debugCheck(self, param, value);
+	}
 }
 
 // Renamed, was Source3f.
 func (self Source) set3f(param int32, value1, value2, value3 float32) {
 	C.alSource3f(C.ALuint(self), C.ALenum(param), C.ALfloat(value1), C.ALfloat(value2), C.ALfloat(value3));
+	if debugMode != DebugOff {
+		debugCheck(self, param, value1, value2, value3);
+	}
 }
 
 // Renamed, was Sourcefv.
 func (self Source) setfv(param int32, values []float32) {
 	C.walSourcefv(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, values);
+	}
 }
 
 // Renamed, was Sourcei.
 func (self Source) seti(param int32, value int32) {
 	C.alSourcei(C.ALuint(self), C.ALenum(param), C.ALint(value));
+	if debugMode != DebugOff {
+		debugCheck(self, param, value);
+	}
 }
 
 // Renamed, was Source3i.
 func (self Source) set3i(param int32, value1, value2, value3 int32) {
 	C.alSource3i(C.ALuint(self), C.ALenum(param), C.ALint(value1), C.ALint(value2), C.ALint(value3));
+	if debugMode != DebugOff {
+		debugCheck(self, param, value1, value2, value3);
+	}
 }
 
 // Renamed, was Sourceiv.
 func (self Source) setiv(param int32, values []int32) {
 	C.walSourceiv(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, values);
+	}
 }
 
 // Renamed, was GetSourcef.
 func (self Source) getf(param int32) float32 {
-	return float32(C.walGetSourcef(C.ALuint(self), C.ALenum(param)));
+	result := float32(C.walGetSourcef(C.ALuint(self), C.ALenum(param)));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
+	return result;
 }
 
 // Renamed, was GetSource3f.
@@ -127,6 +230,9 @@ func (self Source) get3f(param int32) (value1, value2, value3 float32) {
 	var v1, v2, v3 float32;
 	C.walGetSource3f(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&v1),
 		unsafe.Pointer(&v2), unsafe.Pointer(&v3));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
 	value1, value2, value3 = v1, v2, v3;
 	return;
 }
@@ -134,11 +240,18 @@ func (self Source) get3f(param int32) (value1, value2, value3 float32) {
 // Renamed, was GetSourcefv.
 func (self Source) getfv(param int32, values []float32) {
 	C.walGetSourcefv(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, values);
+	}
 }
 
 // Renamed, was GetSourcei.
 func (self Source) geti(param int32) int32 {
-	return int32(C.walGetSourcei(C.ALuint(self), C.ALenum(param)));
+	result := int32(C.walGetSourcei(C.ALuint(self), C.ALenum(param)));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
+	return result;
 }
 
 // Renamed, was GetSource3i.
@@ -146,6 +259,9 @@ func (self Source) get3i(param int32) (value1, value2, value3 int32) {
 	var v1, v2, v3 int32;
 	C.walGetSource3i(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&v1),
 		unsafe.Pointer(&v2), unsafe.Pointer(&v3));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
 	value1, value2, value3 = v1, v2, v3;
 	return;
 }
@@ -153,36 +269,57 @@ func (self Source) get3i(param int32) (value1, value2, value3 int32) {
 // Renamed, was GetSourceiv.
 func (self Source) getiv(param int32, values []int32) {
 	C.walGetSourceiv(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, values);
+	}
 }
 
 // Renamed, was SourcePlay.
 func (self Source) Play() {
 	C.alSourcePlay(C.ALuint(self));
+	if debugMode != DebugOff {
+		debugCheck(self);
+	}
 }
 
 // Renamed, was SourceStop.
 func (self Source) Stop() {
 	C.alSourceStop(C.ALuint(self));
+	if debugMode != DebugOff {
+		debugCheck(self);
+	}
 }
 
 // Renamed, was SourceRewind.
 func (self Source) Rewind() {
 	C.alSourceRewind(C.ALuint(self));
+	if debugMode != DebugOff {
+		debugCheck(self);
+	}
 }
 
 // Renamed, was SourcePause.
 func (self Source) Pause() {
 	C.alSourcePause(C.ALuint(self));
+	if debugMode != DebugOff {
+		debugCheck(self);
+	}
 }
 
 // Renamed, was SourceQueueBuffers.
 func (self Source) QueueBuffers(buffers []Buffer) {
 	C.walSourceQueueBuffers(C.ALuint(self), C.ALsizei(len(buffers)), unsafe.Pointer(&buffers[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, buffers);
+	}
 }
 
 // Renamed, was SourceUnqueueBuffers.
 func (self Source) UnqueueBuffers(buffers []Buffer) {
 	C.walSourceUnqueueBuffers(C.ALuint(self), C.ALsizei(len(buffers)), unsafe.Pointer(&buffers[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, buffers);
+	}
 }
 
 ///// Convenience ////////////////////////////////////////////////////
@@ -190,23 +327,42 @@ func (self Source) UnqueueBuffers(buffers []Buffer) {
 // NewSource() creates a single source.
 // Convenience function, see NewSources().
 func NewSource() Source {
-	return Source(C.walGenSource());
+	var result Source;
+	if succeeded(func() { result = Source(C.walGenSource()) }) {
+		trackSources([]Source{result});
+	}
+	if debugMode != DebugOff {
+		debugCheck();
+	}
+	return result;
 }
 
 // DeleteSource() deletes a single source.
 // Convenience function, see DeleteSources().
 func DeleteSource(source Source) {
-	C.walDeleteSource(C.ALuint(source));
+	if succeeded(func() { C.walDeleteSource(C.ALuint(source)) }) {
+		untrackSources([]Source{source});
+	}
+	if debugMode != DebugOff {
+		debugCheck(source);
+	}
 }
 
 // Convenience method, see Source.QueueBuffers().
 func (self Source) QueueBuffer(buffer Buffer) {
 	C.walSourceQueueBuffer(C.ALuint(self), C.ALuint(buffer));
+	if debugMode != DebugOff {
+		debugCheck(self, buffer);
+	}
 }
 
 // Convenience method, see Source.QueueBuffers().
 func (self Source) UnqueueBuffer() Buffer {
-	return Buffer(C.walSourceUnqueueBuffer(C.ALuint(self)));
+	result := Buffer(C.walSourceUnqueueBuffer(C.ALuint(self)));
+	if debugMode != DebugOff {
+		debugCheck(self);
+	}
+	return result;
 }
 
 // Source queries.
@@ -0,0 +1,177 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package al
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include "wrapper.h"
+*/
+import "C"
+
+// Source properties from various extensions, each one is
+// noted below. Check IsExtensionPresent() before relying
+// on any of them.
+const (
+	alSourceDistanceModel = 0x200; // AL_EXT_source_distance_model (capability)
+	alStereoAngles = 0x1030; // AL_EXT_STEREO_ANGLES
+	alSourceRadius = 0x1031; // AL_EXT_SOURCE_RADIUS
+	alDirectChannels = 0x1033; // AL_SOFT_direct_channels
+	alNumResamplers = 0x1210; // AL_SOFT_source_resampler
+	alDefaultResampler = 0x1211;
+	alSourceResampler = 0x1212;
+	alResamplerName = 0x1213;
+	alSourceSpatialize = 0x1214; // AL_SOFT_source_spatialize
+)
+
+// Modes for Source.SetDirectChannels(). DropUnmatched and
+// RemixUnmatched say what to do with channels the output
+// doesn't have, the latter needs
+// AL_SOFT_direct_channels_remix.
+const (
+	DirectChannelsOff = 0x0000;
+	DropUnmatched = 0x0001;
+	RemixUnmatched = 0x0002;
+)
+
+// Modes for Source.SetSpatialize(). SpatializeAuto, the
+// default, spatializes mono sources only.
+const (
+	SpatializeOff = 0x0000;
+	SpatializeOn = 0x0001;
+	SpatializeAuto = 0x0002;
+)
+
+// SetDirectChannels() makes the source play multichannel
+// buffers straight to the matching output channels instead
+// of virtual speakers, see DropUnmatched. Use
+// DirectChannelsOff to go back to normal.
+// Convenience method, see Source.Seti().
+func (self Source) SetDirectChannels(mode int32) {
+	self.seti(alDirectChannels, mode);
+}
+
+// Convenience method, see Source.Geti().
+func (self Source) GetDirectChannels() int32 {
+	return self.geti(alDirectChannels);
+}
+
+// SetSpatialize() says whether the source gets 3d
+// positioning, see SpatializeAuto.
+// Convenience method, see Source.Seti().
+func (self Source) SetSpatialize(mode int32) {
+	self.seti(alSourceSpatialize, mode);
+}
+
+// Convenience method, see Source.Geti().
+func (self Source) GetSpatialize() int32 {
+	return self.geti(alSourceSpatialize);
+}
+
+// SetResampler() picks the resampler for the source, an
+// index into Resamplers().
+// Convenience method, see Source.Seti().
+func (self Source) SetResampler(index int32) {
+	self.seti(alSourceResampler, index);
+}
+
+// Convenience method, see Source.Geti().
+func (self Source) GetResampler() int32 {
+	return self.geti(alSourceResampler);
+}
+
+// GetStringi() queries one of a list of strings, for
+// example a resampler name.
+// Renamed, was GetStringiSOFT.
+func GetStringi(param int32, index int32) string {
+	result := C.GoString(C.walGetStringiSOFT(C.ALenum(param), C.ALsizei(index)));
+	if debugMode != DebugOff {
+		debugCheck(param, index);
+	}
+	return result;
+}
+
+// Resamplers() returns the names of the resamplers we can
+// pick with Source.SetResampler(), in order.
+// Convenience function, see GetStringi().
+func Resamplers() (names []string) {
+	n := getInteger(alNumResamplers);
+	for i := int32(0); i < n; i++ {
+		names = append(names, GetStringi(alResamplerName, i));
+	}
+	return;
+}
+
+// DefaultResampler() returns the index of the resampler
+// sources use unless told otherwise.
+// Convenience function, see GetInteger().
+func DefaultResampler() int32 {
+	return getInteger(alDefaultResampler);
+}
+
+// SetRadius() makes the source a sphere of the given
+// radius instead of a point, which sounds more natural up
+// close.
+// Convenience method, see Source.Setf().
+func (self Source) SetRadius(radius float32) {
+	self.setf(alSourceRadius, radius);
+}
+
+// Convenience method, see Source.Getf().
+func (self Source) GetRadius() float32 {
+	return self.getf(alSourceRadius);
+}
+
+// SetStereoAngles() places the left and right channels of
+// a stereo source, in radians counter-clockwise from
+// straight ahead. The default is pi/6 and -pi/6.
+// Convenience method, see Source.Setfv().
+func (self Source) SetStereoAngles(left, right float32) {
+	self.setfv(alStereoAngles, []float32{left, right});
+}
+
+// Convenience method, see Source.Getfv().
+func (self Source) GetStereoAngles() (left, right float32) {
+	t := []float32{0, 0};
+	self.getfv(alStereoAngles, t);
+	return t[0], t[1];
+}
+
+// SetSourceDistanceModels() makes each source use its own
+// distance model (see Source.SetDistanceModel()) instead of
+// the global one (see SetDistanceModel()).
+// Renamed, was Enable/Disable(AL_SOURCE_DISTANCE_MODEL).
+func SetSourceDistanceModels(yes bool) {
+	if yes {
+		C.alEnable(alSourceDistanceModel);
+	} else {
+		C.alDisable(alSourceDistanceModel);
+	}
+	if debugMode != DebugOff {
+		debugCheck(yes);
+	}
+}
+
+// Renamed, was IsEnabled(AL_SOURCE_DISTANCE_MODEL).
+func GetSourceDistanceModels() bool {
+	result := C.alIsEnabled(alSourceDistanceModel) != alFalse;
+	if debugMode != DebugOff {
+		debugCheck();
+	}
+	return result;
+}
+
+// SetDistanceModel() sets the distance model for the
+// source, which only counts if SetSourceDistanceModels()
+// is on.
+// Convenience method, see Source.Seti().
+func (self Source) SetDistanceModel(model int32) {
+	self.seti(alDistanceModel, model);
+}
+
+// Convenience method, see Source.Geti().
+func (self Source) GetDistanceModel() int32 {
+	return self.geti(alDistanceModel);
+}
@@ -54,3 +54,39 @@ func GetRenderer() string {
 func GetExtensions() string {
 	return GetString(alExtensions);
 }
+
+// Channels and bytes per sample for each format we know.
+var formatSizes = map[int32][2]int{
+	FormatMono8: {1, 1}, FormatMono16: {1, 2},
+	FormatStereo8: {2, 1}, FormatStereo16: {2, 2},
+	FormatMonoFloat32: {1, 4}, FormatStereoFloat32: {2, 4},
+	FormatMonoDouble: {1, 8}, FormatStereoDouble: {2, 8},
+	FormatQuad8: {4, 1}, FormatQuad16: {4, 2}, FormatQuad32: {4, 4},
+	FormatRear8: {2, 1}, FormatRear16: {2, 2}, FormatRear32: {2, 4},
+	Format51Chn8: {6, 1}, Format51Chn16: {6, 2}, Format51Chn32: {6, 4},
+	Format61Chn8: {7, 1}, Format61Chn16: {7, 2}, Format61Chn32: {7, 4},
+	Format71Chn8: {8, 1}, Format71Chn16: {8, 2}, Format71Chn32: {8, 4},
+	FormatMonoMulaw: {1, 1}, FormatStereoMulaw: {2, 1},
+	FormatMonoAlaw: {1, 1}, FormatStereoAlaw: {2, 1},
+	FormatBFormat2D8: {3, 1}, FormatBFormat2D16: {3, 2}, FormatBFormat2D32: {3, 4},
+	FormatBFormat3D8: {4, 1}, FormatBFormat3D16: {4, 2}, FormatBFormat3D32: {4, 4},
+	FormatUHJ2Chn8: {2, 1}, FormatUHJ2Chn16: {2, 2}, FormatUHJ2Chn32: {2, 4},
+	FormatUHJ3Chn8: {3, 1}, FormatUHJ3Chn16: {3, 2}, FormatUHJ3Chn32: {3, 4},
+	FormatUHJ4Chn8: {4, 1}, FormatUHJ4Chn16: {4, 2}, FormatUHJ4Chn32: {4, 4},
+}
+
+// FormatChannels() returns the number of channels for the
+// given format, or 0 if we don't know the format.
+func FormatChannels(format int32) int {
+	return formatSizes[format][0];
+}
+
+// FrameSize() returns the size, in bytes, of one sample
+// frame (one sample for each channel) in the given format,
+// or 0 if we don't know the format. IMA4 and MSADPCM have
+// no frame size since they come in blocks. B-Format sizes
+// are for first order, see BFormat.Channels() otherwise.
+func FrameSize(format int32) int {
+	size := formatSizes[format];
+	return size[0] * size[1];
+}
@@ -2,7 +2,10 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
+#include <stddef.h>
+#include <stdint.h>
 #include <AL/al.h>
+#include <AL/alc.h>
 #include "wrapper.h"
 
 const char *walGetString(ALenum param) {
@@ -25,6 +28,32 @@ void walGetDoublev(ALenum param, void* data) {
 	alGetDoublev(param, data);
 }
 
+// Extensions
+
+ALboolean walIsExtensionPresent(const char *extname) {
+	return alIsExtensionPresent(extname);
+}
+
+ALenum walGetEnumValue(const char *ename) {
+	return alGetEnumValue(ename);
+}
+
+// WAL_PROC(type, name) defines a static function wal_name()
+// that returns the entry point for the extension function
+// name as a pointer of the given type, or NULL if it isn't
+// available. The entry point is looked up once and cached
+// from then on; failed lookups are retried next time since
+// some implementations only know about extensions once a
+// context is current.
+#define WAL_PROC(type, name) \
+	static type wal_##name(void) { \
+		static type proc = NULL; \
+		if (proc == NULL) { \
+			proc = (type) alGetProcAddress(#name); \
+		} \
+		return proc; \
+	}
+
 // Listeners
 
 void walListenerfv(ALenum param, const void* values) {
@@ -210,3 +239,183 @@ ALuint walSourceUnqueueBuffer(ALuint sid) {
 	alSourceUnqueueBuffers(sid, 1, &result);
 	return result;
 }
+
+// AL_SOFT_source_latency
+
+typedef void (*walGetSourcedvFunc)(ALuint source, ALenum param, ALdouble *values);
+typedef void (*walGetSourcei64vFunc)(ALuint source, ALenum param, void *values);
+
+WAL_PROC(walGetSourcedvFunc, alGetSourcedvSOFT)
+WAL_PROC(walGetSourcei64vFunc, alGetSourcei64vSOFT)
+
+void walGetSourcedvSOFT(ALuint sid, ALenum param, void *values) {
+	walGetSourcedvFunc f = wal_alGetSourcedvSOFT();
+	if (f != NULL) {
+		f(sid, param, values);
+	}
+}
+
+void walGetSourcei64vSOFT(ALuint sid, ALenum param, void *values) {
+	walGetSourcei64vFunc f = wal_alGetSourcei64vSOFT();
+	if (f != NULL) {
+		f(sid, param, values);
+	}
+}
+
+// AL_SOFT_callback_buffer
+//
+// OpenAL calls walBufferCallbackProc() from its mixer thread
+// whenever it needs more samples. We pass an id as the user
+// pointer so the Go side can find the callback for the
+// buffer, see walBufferCallback() in callback.go. Buffer
+// names alone won't do since every device has its own.
+
+typedef ALsizei (*walBufferCallbackProc)(void *userptr, void *sampledata, ALsizei numbytes);
+typedef void (*walBufferCallbackFunc)(ALuint buffer, ALenum format, ALsizei freq, walBufferCallbackProc callback, void *userptr);
+
+WAL_PROC(walBufferCallbackFunc, alBufferCallbackSOFT)
+
+static ALsizei walBufferCallbackTrampoline(void *userptr, void *sampledata, ALsizei numbytes) {
+	return walBufferCallback((ALuint) (uintptr_t) userptr, sampledata, numbytes);
+}
+
+void walBufferCallbackSOFT(ALuint bid, ALenum format, ALsizei freq, ALuint id) {
+	walBufferCallbackFunc f = wal_alBufferCallbackSOFT();
+	if (f != NULL) {
+		f(bid, format, freq, walBufferCallbackTrampoline, (void *) (uintptr_t) id);
+	}
+}
+
+// walCurrentDevice() returns the device our buffers belong
+// to right now, NULL if there's no current context.
+void *walCurrentDevice(void) {
+	ALCcontext *context = alcGetCurrentContext();
+	if (context == NULL) {
+		return NULL;
+	}
+	return alcGetContextsDevice(context);
+}
+
+// walCurrentContext() returns the context our sources belong
+// to right now, NULL if there is none.
+void *walCurrentContext(void) {
+	return alcGetCurrentContext();
+}
+
+// walSwapThreadContext() makes context current for the
+// calling thread only and stores the thread's context from
+// before in previous. Without ALC_EXT_thread_local_context
+// it returns AL_FALSE and changes nothing.
+
+typedef ALCboolean (*walcSetThreadContextFunc)(ALCcontext *context);
+typedef ALCcontext *(*walcGetThreadContextFunc)(void);
+
+ALboolean walSwapThreadContext(void *context, void **previous) {
+	walcSetThreadContextFunc set;
+	walcGetThreadContextFunc get;
+	if (!alcIsExtensionPresent(NULL, "ALC_EXT_thread_local_context")) {
+		return AL_FALSE;
+	}
+	set = (walcSetThreadContextFunc) alcGetProcAddress(NULL, "alcSetThreadContext");
+	get = (walcGetThreadContextFunc) alcGetProcAddress(NULL, "alcGetThreadContext");
+	if (set == NULL || get == NULL) {
+		return AL_FALSE;
+	}
+	*previous = get();
+	return set(context) ? AL_TRUE : AL_FALSE;
+}
+
+// AL_SOFT_events
+//
+// Like callback buffers, events arrive on a thread inside
+// OpenAL; walEventProcSOFT() passes them on to the Go side,
+// see walHandleEvent() in events.go. The user pointer is
+// the context we installed the callback for.
+
+typedef void (*walEventProc)(ALenum eventType, ALuint object, ALuint param, ALsizei length, const ALchar *message, void *userParam);
+typedef void (*walEventControlFunc)(ALsizei count, const ALenum *types, ALboolean enable);
+typedef void (*walEventCallbackFunc)(walEventProc callback, void *userParam);
+
+WAL_PROC(walEventControlFunc, alEventControlSOFT)
+WAL_PROC(walEventCallbackFunc, alEventCallbackSOFT)
+
+static void walEventProcSOFT(ALenum eventType, ALuint object, ALuint param, ALsizei length, const ALchar *message, void *userParam) {
+	walHandleEvent(eventType, object, param, length, (char *) message, userParam);
+}
+
+void walEventControlSOFT(ALsizei count, const void *types, ALboolean enable) {
+	walEventControlFunc f = wal_alEventControlSOFT();
+	if (f != NULL) {
+		f(count, types, enable);
+	}
+}
+
+void walEventCallbackSOFT(void *context) {
+	walEventCallbackFunc f = wal_alEventCallbackSOFT();
+	if (f != NULL) {
+		f(walEventProcSOFT, context);
+	}
+}
+
+// AL_SOFT_deferred_updates
+
+typedef void (*walVoidFunc)(void);
+
+WAL_PROC(walVoidFunc, alDeferUpdatesSOFT)
+WAL_PROC(walVoidFunc, alProcessUpdatesSOFT)
+
+void walDeferUpdatesSOFT(void) {
+	walVoidFunc f = wal_alDeferUpdatesSOFT();
+	if (f != NULL) {
+		f();
+	}
+}
+
+void walProcessUpdatesSOFT(void) {
+	walVoidFunc f = wal_alProcessUpdatesSOFT();
+	if (f != NULL) {
+		f();
+	}
+}
+
+// AL_SOFT_source_resampler
+
+typedef const ALchar *(*walGetStringiFunc)(ALenum param, ALsizei index);
+
+WAL_PROC(walGetStringiFunc, alGetStringiSOFT)
+
+const char *walGetStringiSOFT(ALenum param, ALsizei index) {
+	walGetStringiFunc f = wal_alGetStringiSOFT();
+	if (f == NULL) {
+		return NULL;
+	}
+	return f(param, index);
+}
+
+// AL_SOFT_source_start_delay
+
+typedef void (*walSourcePlayAtTimeFunc)(ALuint source, int64_t start_time);
+typedef void (*walSourcePlayAtTimevFunc)(ALsizei n, const ALuint *sources, int64_t start_time);
+
+WAL_PROC(walSourcePlayAtTimeFunc, alSourcePlayAtTimeSOFT)
+WAL_PROC(walSourcePlayAtTimevFunc, alSourcePlayAtTimevSOFT)
+
+// Both return AL_FALSE if OpenAL lacks the extension.
+
+ALboolean walSourcePlayAtTimeSOFT(ALuint sid, long long start) {
+	walSourcePlayAtTimeFunc f = wal_alSourcePlayAtTimeSOFT();
+	if (f == NULL) {
+		return AL_FALSE;
+	}
+	f(sid, start);
+	return AL_TRUE;
+}
+
+ALboolean walSourcePlayAtTimevSOFT(ALsizei n, const void *sources, long long start) {
+	walSourcePlayAtTimevFunc f = wal_alSourcePlayAtTimevSOFT();
+	if (f == NULL) {
+		return AL_FALSE;
+	}
+	f(n, sources, start);
+	return AL_TRUE;
+}
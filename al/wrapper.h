@@ -16,17 +16,23 @@ void walGetIntegerv(ALenum param, void* data);
 void walGetFloatv(ALenum param, void* data);
 void walGetDoublev(ALenum param, void* data);
 
-// We don't define wrappers for these because we have
-// no clue how to make Go grok C function pointers at
-// runtime. So for now, OpenAL extensions can not be
-// used from Go. If you have an idea for how to make
-// it work, be sure to email! I suspect we'd need a
-// mechanism for generating cgo-style stubs at runtime,
-// sounds like work.
+// Extensions
+
+ALboolean walIsExtensionPresent(const char *extname);
+ALenum walGetEnumValue(const char *ename);
+
+// Go can't call the C function pointers we get from
+// alGetProcAddress(), so extension functions can not
+// be called from Go directly. Instead each extension
+// function we support gets a trampoline in wrapper.c
+// that looks up the entry point the first time it's
+// called, caches it, and calls through it. See the
+// WAL_PROC() macro in wrapper.c for the details.
 //
-// ALboolean alIsExtensionPresent( const ALchar* extname );
-// void* alGetProcAddress( const ALchar* fname );
-// ALenum alGetEnumValue( const ALchar* ename );
+// The trampolines quietly do nothing if the extension
+// is not available, so the Go side should check with
+// IsExtensionPresent() before relying on them. Their
+// prototypes are listed below, grouped by extension.
 
 // Listeners
 
@@ -83,4 +89,41 @@ void walDeleteBuffer(ALuint buffer);
 void walSourceQueueBuffer(ALuint sid, ALuint bid);
 ALuint walSourceUnqueueBuffer(ALuint sid);
 
+// AL_SOFT_source_latency
+
+void walGetSourcedvSOFT(ALuint sid, ALenum param, void *values);
+void walGetSourcei64vSOFT(ALuint sid, ALenum param, void *values);
+
+// AL_SOFT_callback_buffer
+
+void walBufferCallbackSOFT(ALuint bid, ALenum format, ALsizei freq, ALuint id);
+void *walCurrentDevice(void);
+void *walCurrentContext(void);
+ALboolean walSwapThreadContext(void *context, void **previous);
+
+// Exported from callback.go.
+ALsizei walBufferCallback(ALuint id, void *data, ALsizei size);
+
+// AL_SOFT_events
+
+void walEventControlSOFT(ALsizei count, const void *types, ALboolean enable);
+void walEventCallbackSOFT(void *context);
+
+// Exported from events.go.
+void walHandleEvent(ALenum eventType, ALuint object, ALuint param, ALsizei length, char *message, void *context);
+
+// AL_SOFT_deferred_updates
+
+void walDeferUpdatesSOFT(void);
+void walProcessUpdatesSOFT(void);
+
+// AL_SOFT_source_resampler
+
+const char *walGetStringiSOFT(ALenum param, ALsizei index);
+
+// AL_SOFT_source_start_delay
+
+ALboolean walSourcePlayAtTimeSOFT(ALuint sid, long long start);
+ALboolean walSourcePlayAtTimevSOFT(ALsizei n, const void *sources, long long start);
+
 #endif
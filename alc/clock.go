@@ -0,0 +1,59 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alc
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include <AL/alc.h>
+#include "wrappers.h"
+*/
+import "C"
+import "time"
+import "unsafe"
+
+// ALC_SOFT_device_clock extension. Check IsExtensionPresent()
+// on the device first, without it all of these are zero.
+const (
+	alcDeviceClock = 0x1600;
+	alcDeviceLatency = 0x1601;
+	alcDeviceClockLatency = 0x1602;
+)
+
+// GetInteger64v() is like GetIntegerv() but for 64 bit
+// values such as the device clock.
+func (self *Device) GetInteger64v(param int32, size int) (result []int64) {
+	result = make([]int64, size);
+	if size == 0 {
+		return;
+	}
+	C.walcGetInteger64vSOFT(self.handle, C.ALCenum(param), C.ALCsizei(size), unsafe.Pointer(&result[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, size);
+	}
+	return;
+}
+
+// Clock() returns how much audio the device has played
+// since it was opened, in nanoseconds.
+// Convenience method, see Device.GetInteger64v().
+func (self *Device) Clock() time.Duration {
+	return time.Duration(self.GetInteger64v(alcDeviceClock, 1)[0]);
+}
+
+// Latency() returns how long it takes for samples mixed
+// now to actually reach the speakers.
+// Convenience method, see Device.GetInteger64v().
+func (self *Device) Latency() time.Duration {
+	return time.Duration(self.GetInteger64v(alcDeviceLatency, 1)[0]);
+}
+
+// ClockLatency() returns Clock() and Latency() read at the
+// same time, so they fit together.
+// Convenience method, see Device.GetInteger64v().
+func (self *Device) ClockLatency() (clock, latency time.Duration) {
+	values := self.GetInteger64v(alcDeviceClockLatency, 2);
+	return time.Duration(values[0]), time.Duration(values[1]);
+}
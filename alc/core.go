@@ -24,6 +24,7 @@ package alc
 #include "wrappers.c"
 */
 import "C"
+import "strings"
 import "unsafe"
 
 import "openal/al"
@@ -43,6 +44,7 @@ const (
 	OutOfMemory = 0xA005;
 )
 
+// Context attributes, see ContextAttributes.
 const (
 	Frequency = 0x1007; // int Hz
 	Refresh = 0x1008; // int Hz
@@ -51,6 +53,14 @@ const (
 	StereoSources = 0x1011; // int
 )
 
+// ALC_EXT_EFX extension, see openal/efx. MaxAuxiliarySends
+// can also be requested with CreateContextWithAttributes().
+const (
+	EFXMajorVersion = 0x20001;
+	EFXMinorVersion = 0x20002;
+	MaxAuxiliarySends = 0x20003;
+)
+
 // The Specifier string for default device?
 const (
 	DefaultDeviceSpecifier = 0x1004;
@@ -58,13 +68,20 @@ const (
 	Extensions = 0x1006;
 )
 
-// ?
+// ALC_ENUMERATE_ALL_EXT extension, like DefaultDeviceSpecifier
+// and DeviceSpecifier but with full device names.
+const (
+	DefaultAllDevicesSpecifier = 0x1012;
+	AllDevicesSpecifier = 0x1013;
+)
+
+// Device.GetInteger() queries, see Device.Version().
 const (
 	MajorVersion = 0x1000;
 	MinorVersion = 0x1001;
 )
 
-// ?
+// Device.GetInteger() queries, see Device.Attributes().
 const (
 	AttributesSize = 0x1002;
 	AllAttributes = 0x1003;
@@ -83,9 +100,15 @@ type Device struct {
 }
 
 // GetError() returns the most recent error generated
-// in the AL state machine.
-func (self *Device) GetError() uint32 {
-	return uint32(C.alcGetError(self.handle));
+// in the ALC state machine for this device, or nil if
+// there was none. The error is an Error value, see
+// error.go.
+func (self *Device) GetError() error {
+	code := int32(C.alcGetError(self.handle));
+	if code == NoError {
+		return nil;
+	}
+	return Error(code);
 }
 
 func OpenDevice(name string) *Device {
@@ -94,32 +117,296 @@ func OpenDevice(name string) *Device {
 	p := C.CString(name);
 	h := C.walcOpenDevice(p);
 	C.free(unsafe.Pointer(p));
+	if debugMode != DebugOff {
+		debugCheck(nil, name);
+	}
 	return &Device{h};
 }
 
 func (self *Device) CloseDevice() bool {
 	//TODO: really a method? or not?
-	return C.alcCloseDevice(self.handle) != 0;
+	pausedLock.Lock();
+	delete(pausedSources, self.handle);
+	pausedLock.Unlock();
+	loopbackLock.Lock();
+	delete(loopbackFormats, self.handle);
+	loopbackLock.Unlock();
+	al.ForgetDevice(unsafe.Pointer(self.handle));
+	result := C.alcCloseDevice(self.handle) != 0;
+	if debugMode != DebugOff {
+		debugCheck(nil, self.handle);
+	}
+	return result;
 }
 
 func (self *Device) CreateContext() *Context {
 	// TODO: really a method?
-	// TODO: attrlist support
-	return &Context{C.alcCreateContext(self.handle, nil)};
+	list, _ := self.attributeList(ContextAttributes{});
+	return self.createContext(list);
+}
+
+// ContextAttributes describe the context we'd like to get
+// from Device.CreateContextWithAttributes(). Fields left at
+// their zero value are not requested at all, so the device
+// picks its own defaults for those.
+type ContextAttributes struct {
+	Frequency int32; // mixing frequency in Hz
+	Refresh int32; // mixer updates per second
+	Sync bool; // synchronous context (mixing on demand only)
+	MonoSources int32; // voices reserved for mono sources
+	StereoSources int32; // voices reserved for stereo sources
+	MaxAuxiliarySends int32; // EFX sends per source, see openal/efx
+	HRTF HRTFRequest; // binaural rendering, see HRTFRequest
+	HRTFName string; // which HRTF to use, see Device.HRTFs()
+}
+
+// list() turns the attributes into a zero-terminated
+// attribute list for OpenAL, or nil if there are none.
+// Any extra key/value pairs are added to the list as is.
+func (self ContextAttributes) list(extra ...int32) (list []int32) {
+	add := func(key, value int32) {
+		if value != 0 {
+			list = append(list, key, value);
+		}
+	};
+	add(Frequency, self.Frequency);
+	add(Refresh, self.Refresh);
+	if self.Sync {
+		add(Sync, alcTrue);
+	}
+	add(MonoSources, self.MonoSources);
+	add(StereoSources, self.StereoSources);
+	add(MaxAuxiliarySends, self.MaxAuxiliarySends);
+	switch self.HRTF {
+	case HRTFDisable:
+		list = append(list, alcHRTF, alcFalse);
+	case HRTFEnable:
+		list = append(list, alcHRTF, alcTrue);
+	}
+	list = append(list, extra...);
+	if list != nil {
+		list = append(list, 0);
+	}
+	return;
+}
+
+// CreateContextWithAttributes() is like CreateContext() but
+// passes the given attributes on to OpenAL. Note that these
+// are requests, Device.GetInteger() can tell you what you
+// actually got. Loopback devices always get the format they
+// were opened with, whatever the Frequency says.
+//
+// We return ErrInvalidValue if the device doesn't have an
+// HRTF called HRTFName, and the device's error if OpenAL
+// can't create the context.
+func (self *Device) CreateContextWithAttributes(attrs ContextAttributes) (*Context, error) {
+	list, err := self.attributeList(attrs);
+	if err != nil {
+		return nil, err;
+	}
+	result := self.createContext(list);
+	if result.handle == nil {
+		return nil, self.lastError(ErrInvalidValue);
+	}
+	return result, nil;
+}
+
+// attributeList() is like ContextAttributes.list() but also
+// resolves the HRTFName, which needs the device; if there's
+// no such HRTF we return ErrInvalidValue. For a loopback
+// device it adds the render format as well, see
+// OpenLoopbackDevice().
+func (self *Device) attributeList(attrs ContextAttributes, extra ...int32) ([]int32, error) {
+	if format, ok := self.loopbackFormat(); ok {
+		attrs.Frequency = format.frequency;
+		extra = append(extra, alcFormatChannels, format.channels, alcFormatType, format.sampleType);
+	}
+	if attrs.HRTFName != "" {
+		id, ok := self.hrtfID(attrs.HRTFName);
+		if !ok {
+			return nil, ErrInvalidValue;
+		}
+		extra = append([]int32{alcHRTFID, id}, extra...);
+	}
+	return attrs.list(extra...), nil;
+}
+
+// lastError() returns the error that made a call on the
+// device fail, or fallback if debug mode already reported
+// (and cleared) it.
+func (self *Device) lastError(fallback error) error {
+	if err := self.GetError(); err != nil {
+		return err;
+	}
+	return fallback;
+}
+
+// createContext() creates a context from an attribute list
+// made by Device.attributeList().
+func (self *Device) createContext(list []int32) *Context {
+	var p *C.ALCint;
+	if list != nil {
+		p = (*C.ALCint)(unsafe.Pointer(&list[0]));
+	}
+	result := &Context{C.alcCreateContext(self.handle, p)};
+	if debugMode != DebugOff {
+		debugCheck(self, list);
+	}
+	return result;
 }
 
 func (self *Device) GetIntegerv(param uint32, size uint32) (result []int32) {
 	result = make([]int32, size);
 	C.walcGetIntegerv(self.handle, C.ALCenum(param), C.ALCsizei(size), unsafe.Pointer(&result[0]));
+	if debugMode != DebugOff {
+		debugCheck(self, param, size);
+	}
 	return;
 }
 
 func (self *Device) GetInteger(param uint32) int32 {
-	return int32(C.walcGetInteger(self.handle, C.ALCenum(param)));
+	result := int32(C.walcGetInteger(self.handle, C.ALCenum(param)));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
+	return result;
+}
+
+// GetString() queries a string about the device, for
+// example Extensions or DeviceSpecifier.
+func (self *Device) GetString(param int32) string {
+	result := C.GoString(C.walcGetString(self.handle, C.ALCenum(param)));
+	if debugMode != DebugOff {
+		debugCheck(self, param);
+	}
+	return result;
+}
+
+// Extensions() returns the names of the ALC extensions
+// supported by the device.
+// Convenience method, see Device.GetString().
+func (self *Device) Extensions() []string {
+	return strings.Fields(self.GetString(Extensions));
+}
+
+// Version() returns the ALC version of the device.
+// Convenience method, see Device.GetInteger().
+func (self *Device) Version() (major, minor int) {
+	major = int(self.GetInteger(MajorVersion));
+	minor = int(self.GetInteger(MinorVersion));
+	return;
+}
+
+// Attributes() returns the attributes the device is
+// actually using right now, for example the mixing
+// Frequency. These can differ from the ones requested
+// with CreateContextWithAttributes().
+// Convenience method, see Device.GetIntegerv().
+func (self *Device) Attributes() map[int32]int32 {
+	attrs := make(map[int32]int32);
+	size := self.GetInteger(AttributesSize);
+	if size <= 0 {
+		return attrs;
+	}
+	list := self.GetIntegerv(AllAttributes, uint32(size));
+	for i := 0; i+1 < len(list) && list[i] != 0; i += 2 {
+		attrs[list[i]] = list[i+1];
+	}
+	return attrs;
+}
+
+///// Extensions ////////////////////////////////////////////////////
+
+// IsExtensionPresent() checks whether the named extension,
+// for example "ALC_EXT_EFX", is supported by the device.
+func (self *Device) IsExtensionPresent(name string) bool {
+	p := C.CString(name);
+	result := C.walcIsExtensionPresent(self.handle, p) != alcFalse;
+	C.free(unsafe.Pointer(p));
+	if debugMode != DebugOff {
+		debugCheck(self, name);
+	}
+	return result;
+}
+
+// GetEnumValue() returns the value of the named enum, for
+// example "ALC_HRTF_SOFT", or 0 if the device doesn't know
+// about it.
+func (self *Device) GetEnumValue(name string) int32 {
+	p := C.CString(name);
+	result := int32(C.walcGetEnumValue(self.handle, p));
+	C.free(unsafe.Pointer(p));
+	if debugMode != DebugOff {
+		debugCheck(self, name);
+	}
+	return result;
+}
+
+// IsExtensionPresent() checks whether the named extension
+// is supported without asking a particular device. This is
+// how to check for the enumeration extensions for example.
+// Convenience function, see Device.IsExtensionPresent().
+func IsExtensionPresent(name string) bool {
+	return (&Device{}).IsExtensionPresent(name);
 }
 
+///// Enumeration ///////////////////////////////////////////////////
+
+// getString() is alcGetString() without a device.
+func getString(param int32) string {
+	return (&Device{}).GetString(param);
+}
 
+// getStrings() is alcGetString() without a device for
+// those queries that return lists. These are a bunch of
+// NUL-terminated strings with an extra NUL at the end.
+func getStrings(param int32) (list []string) {
+	p := C.walcGetString(nil, C.ALCenum(param));
+	if debugMode != DebugOff {
+		debugCheck(nil, param);
+	}
+	for p != nil && *p != 0 {
+		s := C.GoString(p);
+		list = append(list, s);
+		p = (*C.char)(unsafe.Pointer(uintptr(unsafe.Pointer(p)) + uintptr(len(s) + 1)));
+	}
+	return;
+}
+
+// OutputDevices() returns the names of all output devices
+// for use with OpenDevice(). If ALC_ENUMERATE_ALL_EXT is
+// available we get the full names, which tend to be more
+// specific than the ones from DeviceSpecifier.
+func OutputDevices() []string {
+	if IsExtensionPresent("ALC_ENUMERATE_ALL_EXT") {
+		return getStrings(AllDevicesSpecifier);
+	}
+	return getStrings(DeviceSpecifier);
+}
+
+// DefaultOutputDevice() returns the name of the output
+// device OpenDevice("") would open. See OutputDevices()
+// for the difference ALC_ENUMERATE_ALL_EXT makes.
+func DefaultOutputDevice() string {
+	if IsExtensionPresent("ALC_ENUMERATE_ALL_EXT") {
+		return getString(DefaultAllDevicesSpecifier);
+	}
+	return getString(DefaultDeviceSpecifier);
+}
+
+// CaptureDevices() returns the names of all capture
+// devices for use with CaptureOpenDevice().
+func CaptureDevices() []string {
+	return getStrings(CaptureDeviceSpecifier);
+}
+
+// DefaultCaptureDevice() returns the name of the capture
+// device CaptureOpenDevice("", ...) would open.
+func DefaultCaptureDevice() string {
+	return getString(CaptureDefaultDeviceSpecifier);
+}
 
+///// Capture ///////////////////////////////////////////////////////
 
 type CaptureDevice struct {
 	Device;
@@ -132,7 +419,10 @@ func CaptureOpenDevice(name string, freq uint32, format uint32, size uint32) *Ca
 	p := C.CString(name);
 	h := C.walcCaptureOpenDevice(p, C.ALCuint(freq), C.ALCenum(format), C.ALCsizei(size));
 	C.free(unsafe.Pointer(p));
-	s := map[uint32]uint32{al.FormatMono8: 1, al.FormatMono16: 2, al.FormatStereo8: 2, al.FormatStereo16: 4}[format];
+	if debugMode != DebugOff {
+		debugCheck(nil, name, freq, format, size);
+	}
+	s := uint32(al.FrameSize(int32(format)));
 	return &CaptureDevice{Device{h},s};
 }
 
@@ -140,7 +430,11 @@ func CaptureOpenDevice(name string, freq uint32, format uint32, size uint32) *Ca
 // C function is called even if someone decides to use this
 // behind an interface.
 func (self *CaptureDevice) CloseDevice() bool {
-	return C.alcCaptureCloseDevice(self.handle) != 0;
+	result := C.alcCaptureCloseDevice(self.handle) != 0;
+	if debugMode != DebugOff {
+		debugCheck(nil, self.handle);
+	}
+	return result;
 }
 
 func (self *CaptureDevice) CaptureCloseDevice() bool {
@@ -149,15 +443,24 @@ func (self *CaptureDevice) CaptureCloseDevice() bool {
 
 func (self *CaptureDevice) CaptureStart() {
 	C.alcCaptureStart(self.handle);
+	if debugMode != DebugOff {
+		debugCheck(&self.Device);
+	}
 }
 
 func (self *CaptureDevice) CaptureStop() {
 	C.alcCaptureStop(self.handle);
+	if debugMode != DebugOff {
+		debugCheck(&self.Device);
+	}
 }
 
 func (self *CaptureDevice) CaptureSamples(size uint32) (data []byte) {
 	data = make([]byte, size * self.sampleSize);
 	C.alcCaptureSamples(self.handle, unsafe.Pointer(&data[0]), C.ALCsizei(size));
+	if debugMode != DebugOff {
+		debugCheck(&self.Device, size);
+	}
 	return;
 }
 
@@ -177,31 +480,53 @@ var NullContext Context
 
 // Renamed, was MakeContextCurrent.
 func (self *Context) Activate() bool {
-	return C.alcMakeContextCurrent(self.handle) != alcFalse
+	result := C.alcMakeContextCurrent(self.handle) != alcFalse
+	if debugMode != DebugOff {
+		debugCheck(nil, self.handle)
+	}
+	return result
 }
 
 // Renamed, was ProcessContext.
 func (self *Context) Process() {
 	C.alcProcessContext(self.handle)
+	if debugMode != DebugOff {
+		debugCheck(nil, self.handle)
+	}
 }
 
 // Renamed, was SuspendContext.
 func (self *Context) Suspend() {
 	C.alcSuspendContext(self.handle)
+	if debugMode != DebugOff {
+		debugCheck(nil, self.handle)
+	}
 }
 
 // Renamed, was DestroyContext.
 func (self *Context) Destroy() {
+	al.ForgetContext(unsafe.Pointer(self.handle))
 	C.alcDestroyContext(self.handle)
+	if debugMode != DebugOff {
+		debugCheck(nil, self.handle)
+	}
 	self.handle = nil
 }
 
 // Renamed, was GetContextsDevice.
 func (self *Context) GetDevice() *Device {
-	return &Device{C.alcGetContextsDevice(self.handle)}
+	result := &Device{C.alcGetContextsDevice(self.handle)}
+	if debugMode != DebugOff {
+		debugCheck(nil, self.handle)
+	}
+	return result
 }
 
 // Renamed, was GetCurrentContext.
 func CurrentContext() *Context {
-	return &Context{C.alcGetCurrentContext()}
+	result := &Context{C.alcGetCurrentContext()}
+	if debugMode != DebugOff {
+		debugCheck(nil)
+	}
+	return result
 }
@@ -0,0 +1,57 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Debug mode in pure Go.
+//
+// This works just like debug mode in openal/al, check
+// there for details. The one difference is that ALC
+// errors are tracked per device, so debugCheck() needs
+// to know which device to ask. Errors that don't belong
+// to any particular device (like those for contexts or
+// devices that failed to open) are tracked on the nil
+// device.
+
+package alc
+
+import "openal/al"
+
+// Debug modes for SetDebug(), the same as openal/al's.
+const (
+	DebugOff = al.DebugOff;
+	DebugLog = al.DebugLog;
+	DebugPanic = al.DebugPanic;
+)
+
+var debugMode = DebugOff;
+
+// SetDebug() changes the current debug mode. DebugLog
+// logs every error with the log package, DebugPanic
+// panics instead. DebugOff, the default, turns all
+// checks off again.
+func SetDebug(mode int) {
+	debugMode = mode;
+}
+
+// GetDebug() returns the current debug mode.
+func GetDebug() int {
+	return debugMode;
+}
+
+// debugCheck() is called after every wrapped call while
+// debug mode is on; args are the arguments of the call.
+// Pass nil for device to check the nil device.
+func debugCheck(device *Device, args ...interface{}) {
+	if device == nil {
+		device = &Device{};
+	}
+	if err := device.GetError(); err != nil {
+		debugReport(err, args...);
+	}
+}
+
+// debugReport() reports an error we already got from
+// Device.GetError() the way debugCheck() would have.
+func debugReport(err error, args ...interface{}) {
+	al.DebugReport(debugMode, err, args...);
+}
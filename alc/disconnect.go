@@ -0,0 +1,123 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alc
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include <AL/alc.h>
+#include "wrappers.h"
+*/
+import "C"
+import "sync"
+import "time"
+import "unsafe"
+
+// ALC_EXT_disconnect extension.
+const (
+	alcConnected = 0x313;
+)
+
+// Connected() is false once the device is gone for good,
+// for example because a USB headset was unplugged. The
+// device and its contexts still "work" after that, they
+// just don't make any sound. Devices without the
+// ALC_EXT_disconnect extension always look connected.
+// Convenience method, see Device.GetInteger().
+func (self *Device) Connected() bool {
+	if !self.IsExtensionPresent("ALC_EXT_disconnect") {
+		return true;
+	}
+	return self.GetInteger(alcConnected) != alcFalse;
+}
+
+// Reopen() moves the device over to another output device
+// with the given name, "" meaning the default one. All the
+// contexts, sources and buffers of the device survive.
+// This needs the ALC_SOFT_reopen_device extension. If it
+// fails the device keeps playing where it was, unless it
+// was disconnected already. Errors are as for
+// Device.CreateContextWithAttributes().
+func (self *Device) Reopen(name string, attrs ContextAttributes) error {
+	list, err := self.attributeList(attrs);
+	if err != nil {
+		return err;
+	}
+	var p *C.char;
+	if name != "" {
+		p = C.CString(name);
+		defer C.free(unsafe.Pointer(p));
+	}
+	var q *C.ALCint;
+	if list != nil {
+		q = (*C.ALCint)(unsafe.Pointer(&list[0]));
+	}
+	result := C.walcReopenDeviceSOFT(self.handle, p, q) != alcFalse;
+	if debugMode != DebugOff {
+		debugCheck(self, name, list);
+	}
+	if !result {
+		return self.lastError(ErrInvalidDevice);
+	}
+	return nil;
+}
+
+// Watcher checks on a device in the background and tells
+// us when it disconnects, see Device.Watch().
+type Watcher struct {
+	// The device is sent here each time it disconnects.
+	C <-chan *Device;
+	stop chan bool;
+	once sync.Once;
+}
+
+// Watch() starts a Watcher that checks Connected() every
+// period. Once the device disconnects, the Watcher sends
+// it on C; if we Reopen() it, the Watcher picks up the
+// next disconnect as well. The Watcher never blocks, so
+// if nobody is listening we simply miss the event.
+func (self *Device) Watch(period time.Duration) *Watcher {
+	c := make(chan *Device, 1);
+	w := &Watcher{C: c, stop: make(chan bool)};
+	go func() {
+		ticker := time.NewTicker(period);
+		defer ticker.Stop();
+		connected := true;
+		for {
+			select {
+			case <-w.stop:
+				return;
+			case <-ticker.C:
+			}
+			now := self.connected();
+			if connected && !now {
+				select {
+				case c <- self:
+				default:
+				}
+			}
+			connected = now;
+		}
+	}();
+	return w;
+}
+
+// Stop() stops the Watcher. Calling it again does nothing.
+func (self *Watcher) Stop() {
+	self.once.Do(func() { close(self.stop) });
+}
+
+// connected() is Connected() for the Watcher: it runs in its
+// own goroutine, so it must not go through debugCheck() and
+// take device errors away from the goroutines that caused
+// them. Neither call can fail on a valid device anyway.
+func (self *Device) connected() bool {
+	p := C.CString("ALC_EXT_disconnect");
+	defer C.free(unsafe.Pointer(p));
+	if C.walcIsExtensionPresent(self.handle, p) == alcFalse {
+		return true;
+	}
+	return C.walcGetInteger(self.handle, alcConnected) != alcFalse;
+}
@@ -0,0 +1,52 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Error values in pure Go.
+//
+// These mirror the ones in openal/al, but the ALC error
+// codes overlap with the AL ones (InvalidEnum is 0xA002
+// in "al" and 0xA003 in "alc" for example) so we need a
+// distinct type for errors.Is() to tell them apart.
+
+package alc
+
+import "fmt"
+
+// Error is an error code generated by the ALC state machine.
+type Error int32;
+
+// Sentinel errors for comparisons with errors.Is(). They
+// are simply the error codes from Device.GetError() as
+// Errors.
+var (
+	ErrInvalidDevice error = Error(InvalidDevice);
+	ErrInvalidContext error = Error(InvalidContext);
+	ErrInvalidEnum error = Error(InvalidEnum);
+	ErrInvalidValue error = Error(InvalidValue);
+	ErrOutOfMemory error = Error(OutOfMemory);
+)
+
+var errorNames = map[Error]string{
+	NoError: "NoError",
+	InvalidDevice: "InvalidDevice",
+	InvalidContext: "InvalidContext",
+	InvalidEnum: "InvalidEnum",
+	InvalidValue: "InvalidValue",
+	OutOfMemory: "OutOfMemory",
+}
+
+// String() returns the Go name of the error code, for
+// example "InvalidDevice" for InvalidDevice.
+func (self Error) String() string {
+	name, ok := errorNames[self];
+	if !ok {
+		return fmt.Sprintf("Error(0x%x)", int32(self));
+	}
+	return name;
+}
+
+// Error() makes Error an error.
+func (self Error) Error() string {
+	return "alc: " + self.String();
+}
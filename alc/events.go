@@ -0,0 +1,195 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alc
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include <AL/alc.h>
+#include "wrappers.h"
+*/
+import "C"
+import "sync"
+
+// EventType says what happened, see Event.
+type EventType int32
+
+// Event types for the ALC_SOFT_system_events extension.
+const (
+	DefaultDeviceChanged EventType = 0x19D6;
+	DeviceAdded EventType = 0x19D7;
+	DeviceRemoved EventType = 0x19D8;
+)
+
+// DeviceKind says which kind of device an Event is about.
+type DeviceKind int32
+
+const (
+	Playback DeviceKind = 0x19D4;
+	Capture DeviceKind = 0x19D5;
+)
+
+const (
+	alcEventSupported = 0x19D9;
+	alcEventNotSupported = 0x19DA;
+)
+
+var eventTypeNames = map[EventType]string{
+	DefaultDeviceChanged: "default device changed",
+	DeviceAdded: "device added",
+	DeviceRemoved: "device removed",
+}
+
+func (self EventType) String() string {
+	if name, ok := eventTypeNames[self]; ok {
+		return name;
+	}
+	return "unknown event";
+}
+
+func (self DeviceKind) String() string {
+	switch self {
+	case Playback:
+		return "playback";
+	case Capture:
+		return "capture";
+	}
+	return "unknown device kind";
+}
+
+// Event is a notification from the audio system, for
+// example that the user picked another default output.
+// The Message is for humans and may name the device.
+type Event struct {
+	Type EventType;
+	Kind DeviceKind;
+	Message string;
+}
+
+// EventSupported() checks whether the audio system reports
+// events of the given type for the given kind of device.
+// Without the ALC_SOFT_system_events extension it never
+// does.
+func EventSupported(t EventType, kind DeviceKind) bool {
+	result := C.walcEventIsSupportedSOFT(C.ALCenum(t), C.ALCenum(kind)) == alcEventSupported;
+	if debugMode != DebugOff {
+		debugCheck(nil, t, kind);
+	}
+	return result;
+}
+
+// subscription is one channel registered with Notify().
+type subscription struct {
+	c chan<- Event;
+	types []EventType;
+}
+
+// Two locks keep us from deadlocking with OpenAL, which
+// holds its own event lock while it calls walcHandleEvent().
+// eventLock guards the subscriptions and is never held
+// while we call into OpenAL; controlLock serializes our
+// calls into OpenAL and is never taken by walcHandleEvent().
+var (
+	eventLock sync.Mutex;
+	subscriptions []subscription;
+	controlLock sync.Mutex;
+	eventCallback bool; // installed yet?
+	enabledEvents = make(map[EventType]bool);
+)
+
+var allEventTypes = []EventType{DefaultDeviceChanged, DeviceAdded, DeviceRemoved};
+
+// Notify() makes the package send events of the given
+// types to c, or events of all types if none are given.
+// Events are dropped rather than block OpenAL, so c
+// should be buffered. To follow the default output for
+// example, Reopen() the device with "" each time we see
+// DefaultDeviceChanged for Playback.
+// Needs the ALC_SOFT_system_events extension, so check
+// IsExtensionPresent() first.
+func Notify(c chan<- Event, types ...EventType) {
+	if len(types) == 0 {
+		types = allEventTypes;
+	}
+	eventLock.Lock();
+	subscriptions = append(subscriptions[0:len(subscriptions):len(subscriptions)], subscription{c, types});
+	eventLock.Unlock();
+	updateEvents();
+}
+
+// StopNotify() makes the package stop sending events to c.
+func StopNotify(c chan<- Event) {
+	eventLock.Lock();
+	var kept []subscription;
+	for _, s := range subscriptions {
+		if s.c != c {
+			kept = append(kept, s);
+		}
+	}
+	subscriptions = kept;
+	eventLock.Unlock();
+	updateEvents();
+}
+
+// updateEvents() turns event types on or off in OpenAL so
+// we only get what somebody is waiting for. Must be called
+// without eventLock held, see above.
+func updateEvents() {
+	controlLock.Lock();
+	defer controlLock.Unlock();
+	wanted := make(map[EventType]bool);
+	eventLock.Lock();
+	for _, s := range subscriptions {
+		for _, t := range s.types {
+			wanted[t] = true;
+		}
+	}
+	eventLock.Unlock();
+	if !eventCallback && len(wanted) > 0 {
+		C.walcEventCallbackSOFT();
+		eventCallback = true;
+	}
+	var on, off []C.ALCenum;
+	for _, t := range allEventTypes {
+		if wanted[t] && !enabledEvents[t] {
+			on = append(on, C.ALCenum(t));
+		}
+		if !wanted[t] && enabledEvents[t] {
+			off = append(off, C.ALCenum(t));
+		}
+	}
+	if len(on) > 0 {
+		C.walcEventControlSOFT(C.ALCsizei(len(on)), &on[0], alcTrue);
+	}
+	if len(off) > 0 {
+		C.walcEventControlSOFT(C.ALCsizei(len(off)), &off[0], alcFalse);
+	}
+	if debugMode != DebugOff {
+		debugCheck(nil, on, off);
+	}
+	enabledEvents = wanted;
+}
+
+// walcHandleEvent() runs on an OpenAL thread with OpenAL's
+// event lock held, so it only takes eventLock long enough
+// to grab the current subscriptions.
+//export walcHandleEvent
+func walcHandleEvent(eventType C.ALCenum, deviceType C.ALCenum, device *C.ALCdevice, length C.ALCsizei, message *C.char) {
+	e := Event{EventType(eventType), DeviceKind(deviceType), C.GoStringN(message, C.int(length))};
+	eventLock.Lock();
+	current := subscriptions;
+	eventLock.Unlock();
+	for _, s := range current {
+		for _, t := range s.types {
+			if t == e.Type {
+				select {
+				case s.c <- e:
+				default:
+				}
+				break;
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alc
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include <AL/alc.h>
+#include "wrappers.h"
+*/
+import "C"
+import "unsafe"
+
+// ALC_SOFT_HRTF extension. Check IsExtensionPresent() on
+// the device before relying on any of this.
+const (
+	alcHRTF = 0x1992;
+	alcHRTFStatus = 0x1993;
+	alcNumHRTFSpecifiers = 0x1994;
+	alcHRTFSpecifier = 0x1995;
+	alcHRTFID = 0x1996;
+)
+
+// HRTFRequest is what we ask for in ContextAttributes.HRTF.
+// The zero value leaves the decision to the device, which
+// usually means HRTF only for headphones.
+type HRTFRequest int32
+
+const (
+	HRTFDontCare HRTFRequest = iota;
+	HRTFDisable;
+	HRTFEnable;
+)
+
+// HRTFStatus tells us whether we got HRTF and if not, why
+// not, see Device.HRTFStatus().
+type HRTFStatus int32
+
+const (
+	HRTFDisabled HRTFStatus = 0x0000;
+	HRTFEnabled HRTFStatus = 0x0001;
+	HRTFDenied HRTFStatus = 0x0002; // forbidden by the user's configuration
+	HRTFRequired HRTFStatus = 0x0003; // forced on by the user's configuration
+	HRTFHeadphonesDetected HRTFStatus = 0x0004; // enabled automatically
+	HRTFUnsupportedFormat HRTFStatus = 0x0005; // output isn't stereo or the frequency doesn't match
+)
+
+var hrtfStatusNames = map[HRTFStatus]string{
+	HRTFDisabled: "disabled",
+	HRTFEnabled: "enabled",
+	HRTFDenied: "denied",
+	HRTFRequired: "required",
+	HRTFHeadphonesDetected: "headphones detected",
+	HRTFUnsupportedFormat: "unsupported format",
+}
+
+func (self HRTFStatus) String() string {
+	if name, ok := hrtfStatusNames[self]; ok {
+		return name;
+	}
+	return "unknown HRTF status";
+}
+
+// Active() is true if the device is actually rendering
+// with HRTF in this status.
+func (self HRTFStatus) Active() bool {
+	return self == HRTFEnabled || self == HRTFRequired || self == HRTFHeadphonesDetected;
+}
+
+// GetStringi() queries one of a list of strings about the
+// device, for example an HRTF name.
+func (self *Device) GetStringi(param int32, index int32) string {
+	result := C.GoString(C.walcGetStringiSOFT(self.handle, C.ALCenum(param), C.ALCsizei(index)));
+	if debugMode != DebugOff {
+		debugCheck(self, param, index);
+	}
+	return result;
+}
+
+// HRTFs() returns the names of the HRTF data sets the
+// device can use, in the order of their ids.
+// Convenience method, see Device.GetStringi().
+func (self *Device) HRTFs() (names []string) {
+	n := self.GetInteger(alcNumHRTFSpecifiers);
+	for i := int32(0); i < n; i++ {
+		names = append(names, self.GetStringi(alcHRTFSpecifier, i));
+	}
+	return;
+}
+
+// hrtfID() finds the id of the HRTF with the given name.
+func (self *Device) hrtfID(name string) (int32, bool) {
+	for i, n := range self.HRTFs() {
+		if n == name {
+			return int32(i), true;
+		}
+	}
+	return 0, false;
+}
+
+// HRTFStatus() tells us whether the device renders with
+// HRTF right now and if not, why not.
+// Convenience method, see Device.GetInteger().
+func (self *Device) HRTFStatus() HRTFStatus {
+	return HRTFStatus(self.GetInteger(alcHRTFStatus));
+}
+
+// HRTF() returns the name of the HRTF the device is using,
+// or "" if it's not using HRTF.
+// Convenience method, see Device.GetString().
+func (self *Device) HRTF() string {
+	if self.GetInteger(alcHRTF) == alcFalse {
+		return "";
+	}
+	return self.GetString(alcHRTFSpecifier);
+}
+
+// Reset() re-initializes the device with new attributes
+// without destroying its contexts. This is how to switch
+// HRTF on, off, or to another data set on the fly. Errors
+// are as for Device.CreateContextWithAttributes().
+func (self *Device) Reset(attrs ContextAttributes) error {
+	list, err := self.attributeList(attrs);
+	if err != nil {
+		return err;
+	}
+	var p *C.ALCint;
+	if list != nil {
+		p = (*C.ALCint)(unsafe.Pointer(&list[0]));
+	}
+	result := C.walcResetDeviceSOFT(self.handle, p) != alcFalse;
+	if debugMode != DebugOff {
+		debugCheck(self, list);
+	}
+	if !result {
+		return self.lastError(ErrInvalidDevice);
+	}
+	return nil;
+}
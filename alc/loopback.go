@@ -0,0 +1,149 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alc
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include <AL/alc.h>
+#include "wrappers.h"
+*/
+import "C"
+import "sync"
+import "unsafe"
+
+// Sample types for OpenLoopbackDevice().
+const (
+	Byte = 0x1400;
+	UnsignedByte = 0x1401;
+	Short = 0x1402;
+	UnsignedShort = 0x1403;
+	Int = 0x1404;
+	UnsignedInt = 0x1405;
+	Float = 0x1406;
+)
+
+// Channel layouts for OpenLoopbackDevice().
+const (
+	Mono = 0x1500;
+	Stereo = 0x1501;
+	Quad = 0x1503;
+	Surround51 = 0x1504;
+	Surround61 = 0x1505;
+	Surround71 = 0x1506;
+)
+
+// Context attributes for loopback devices. We add them
+// for you, see LoopbackDevice.CreateContext().
+const (
+	alcFormatChannels = 0x1990;
+	alcFormatType = 0x1991;
+)
+
+var channelCounts = map[int32]int{
+	Mono: 1, Stereo: 2, Quad: 4, Surround51: 6, Surround61: 7, Surround71: 8,
+}
+
+var sampleSizes = map[int32]int{
+	Byte: 1, UnsignedByte: 1, Short: 2, UnsignedShort: 2,
+	Int: 4, UnsignedInt: 4, Float: 4,
+}
+
+// LoopbackDevice is a device that doesn't play anything
+// but hands the mixed samples back to us instead, see
+// RenderSamples(). This is how to use OpenAL without a
+// sound card, for offline rendering or in tests.
+//
+// Loopback devices need the ALC_SOFT_loopback extension,
+// so check IsExtensionPresent("ALC_SOFT_loopback") first.
+type LoopbackDevice struct {
+	Device;
+	frameSize int;
+}
+
+// loopbackFormat is the format a loopback device renders
+// in, which OpenAL needs to see in the attributes every
+// time we create a context for the device or reset it.
+type loopbackFormat struct {
+	frequency int32;
+	channels int32;
+	sampleType int32;
+}
+
+// The formats of all open loopback devices. We keep them
+// by handle rather than in LoopbackDevice so that every
+// Device for the handle (see Context.GetDevice()) passes
+// them along, see Device.attributeList().
+var (
+	loopbackLock sync.Mutex;
+	loopbackFormats = make(map[*C.ALCdevice]loopbackFormat);
+)
+
+// loopbackFormat() returns the render format if the device
+// is a loopback device.
+func (self *Device) loopbackFormat() (format loopbackFormat, ok bool) {
+	loopbackLock.Lock();
+	format, ok = loopbackFormats[self.handle];
+	loopbackLock.Unlock();
+	return;
+}
+
+// OpenLoopbackDevice() opens a loopback device that will
+// render samples at the given frequency in Hz, with the
+// given channel layout (Stereo for example) and sample
+// type (Short for example). We return ErrInvalidValue for
+// a format we don't know and ErrInvalidDevice if OpenAL
+// can't open the device.
+func OpenLoopbackDevice(frequency int32, channels int32, sampleType int32) (*LoopbackDevice, error) {
+	size := channelCounts[channels] * sampleSizes[sampleType];
+	if size == 0 || frequency <= 0 {
+		return nil, ErrInvalidValue;
+	}
+	h := C.walcLoopbackOpenDeviceSOFT();
+	if debugMode != DebugOff {
+		debugCheck(nil, frequency, channels, sampleType);
+	}
+	if h == nil {
+		return nil, ErrInvalidDevice;
+	}
+	loopbackLock.Lock();
+	loopbackFormats[h] = loopbackFormat{frequency, channels, sampleType};
+	loopbackLock.Unlock();
+	return &LoopbackDevice{Device{h}, size}, nil;
+}
+
+// IsRenderFormatSupported() checks whether the device can
+// render in the given format, see OpenLoopbackDevice().
+func (self *LoopbackDevice) IsRenderFormatSupported(frequency int32, channels int32, sampleType int32) bool {
+	result := C.walcIsRenderFormatSupportedSOFT(self.handle, C.ALCsizei(frequency),
+		C.ALCenum(channels), C.ALCenum(sampleType)) != alcFalse;
+	if debugMode != DebugOff {
+		debugCheck(&self.Device, frequency, channels, sampleType);
+	}
+	return result;
+}
+
+// FrameSize() returns the size, in bytes, of one sample
+// frame (one sample for each channel) from RenderSamples().
+func (self *LoopbackDevice) FrameSize() int {
+	return self.frameSize;
+}
+
+// RenderSamples() mixes the given number of sample frames
+// for the current context of the device and returns them
+// in the format the device was opened with. There must be
+// a context for the device, see Device.CreateContext(),
+// which always asks for that format.
+func (self *LoopbackDevice) RenderSamples(frames int) (data []byte) {
+	data = make([]byte, frames * self.frameSize);
+	if len(data) == 0 {
+		return;
+	}
+	C.walcRenderSamplesSOFT(self.handle, unsafe.Pointer(&data[0]), C.ALCsizei(frames));
+	if debugMode != DebugOff {
+		debugCheck(&self.Device, frames);
+	}
+	return;
+}
@@ -0,0 +1,116 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package alc
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include <AL/alc.h>
+#include "wrappers.h"
+*/
+import "C"
+import "sync"
+
+import "openal/al"
+
+// Sources we paused ourselves because the device can't
+// pause, see Device.Pause(). We can't keep them in the
+// Device since there can be several Devices for the same
+// handle, see Context.GetDevice().
+var (
+	pausedLock sync.Mutex;
+	pausedSources = make(map[*C.ALCdevice][]al.Source);
+)
+
+// CanPause() checks whether the device can really pause,
+// which needs the ALC_SOFT_pause_device extension.
+// Convenience method, see Device.IsExtensionPresent().
+func (self *Device) CanPause() bool {
+	return self.IsExtensionPresent("ALC_SOFT_pause_device");
+}
+
+// Pause() stops the device from mixing altogether, which
+// saves power while we're in the background. Unlike
+// Context.Suspend() this stops the mixer thread as well.
+// Everything else still works, it just doesn't play.
+//
+// If the device can't pause (see CanPause()) we pause its
+// playing sources instead. Those are the sources given, or
+// without any, all sources of the current context (see
+// al.Sources()), which must then belong to the device;
+// otherwise we return ErrInvalidContext. Pausing again
+// before Resume() only adds sources that weren't paused
+// yet. If the device can pause the sources are not needed
+// and not looked at, so it's fine to always pass them.
+func (self *Device) Pause(sources ...al.Source) error {
+	if self.CanPause() {
+		C.walcDevicePauseSOFT(self.handle);
+		err := self.GetError();
+		if err != nil && debugMode != DebugOff {
+			debugReport(err, self);
+		}
+		return err;
+	}
+	if len(sources) == 0 {
+		context := C.alcGetCurrentContext();
+		if context == nil || C.alcGetContextsDevice(context) != self.handle {
+			return ErrInvalidContext;
+		}
+		sources = al.Sources();
+	}
+	var playing []al.Source;
+	for _, s := range sources {
+		if s.State() == al.Playing {
+			playing = append(playing, s);
+		}
+	}
+	if len(playing) > 0 {
+		al.PauseSources(playing);
+	}
+	pausedLock.Lock();
+	paused := pausedSources[self.handle];
+	for _, s := range playing {
+		known := false;
+		for _, p := range paused {
+			if p == s {
+				known = true;
+				break;
+			}
+		}
+		if !known {
+			paused = append(paused, s);
+		}
+	}
+	pausedSources[self.handle] = paused;
+	pausedLock.Unlock();
+	return nil;
+}
+
+// Resume() undoes Pause(). If we paused sources instead of
+// the device, we only play those sources that are still
+// paused, so sources stopped or played in the meantime are
+// left alone.
+func (self *Device) Resume() {
+	if self.CanPause() {
+		C.walcDeviceResumeSOFT(self.handle);
+		if debugMode != DebugOff {
+			debugCheck(self);
+		}
+		return;
+	}
+	pausedLock.Lock();
+	sources := pausedSources[self.handle];
+	delete(pausedSources, self.handle);
+	pausedLock.Unlock();
+	var paused []al.Source;
+	for _, s := range sources {
+		if s.State() == al.Paused {
+			paused = append(paused, s);
+		}
+	}
+	if len(paused) > 0 {
+		al.PlaySources(paused);
+	}
+}
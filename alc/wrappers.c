@@ -1,3 +1,5 @@
+#include "wrappers.h"
+
 // It's sad but the OpenAL C API uses lots and lots of typedefs
 // that require wrapper functions (using basic C types) for cgo
 // to grok them. So there's a lot more C code here than I would
@@ -21,14 +23,21 @@ ALCdevice *walcOpenDevice(const char *devicename) {
 // ALCboolean alcCloseDevice( ALCdevice *device );
 // ALCenum alcGetError( ALCdevice *device );
 
-// We don't define wrappers for these, see openal/al
-// for details.
-//
-// ALCboolean alcIsExtensionPresent( ALCdevice *device, const ALCchar *extname );
+//ALCboolean alcIsExtensionPresent( ALCdevice *device, const ALCchar *extname );
+ALCboolean walcIsExtensionPresent(ALCdevice *device, const char *extname) {
+	return alcIsExtensionPresent(device, extname);
+}
 // void *alcGetProcAddress( ALCdevice *device, const ALCchar *funcname );
-// ALCenum alcGetEnumValue( ALCdevice *device, const ALCchar *enumname );
+// (See WALC_PROC() below.)
+//ALCenum alcGetEnumValue( ALCdevice *device, const ALCchar *enumname );
+ALCenum walcGetEnumValue(ALCdevice *device, const char *enumname) {
+	return alcGetEnumValue(device, enumname);
+}
 
-const ALCchar *alcGetString( ALCdevice *device, ALCenum param );
+//const ALCchar *alcGetString( ALCdevice *device, ALCenum param );
+const char *walcGetString(ALCdevice *device, ALCenum param) {
+	return alcGetString(device, param);
+}
 //void alcGetIntegerv( ALCdevice *device, ALCenum param, ALCsizei size, ALCint *data );
 void walcGetIntegerv(ALCdevice *device, ALCenum param, ALCsizei size, void *data) {
 	alcGetIntegerv(device, param, size, data);
@@ -52,3 +61,172 @@ ALCint walcGetInteger(ALCdevice *device, ALCenum param) {
 	alcGetIntegerv(device, param, 1, &result);
 	return result;
 }
+
+// Extensions
+//
+// This works just like it does in openal/al: Go can't call
+// the function pointers we get from alcGetProcAddress(), so
+// each extension function we support gets a trampoline that
+// calls through a cached entry point defined by WALC_PROC().
+// ALC entry points can in principle differ between devices,
+// but no implementation we know of actually does that, so
+// we only cache one per function. The trampolines quietly
+// do nothing if the entry point isn't available; the Go side
+// should check Device.IsExtensionPresent() first.
+
+#define WALC_PROC(type, name) \
+	static type walc_##name(ALCdevice *device) { \
+		static type proc = NULL; \
+		if (proc == NULL) { \
+			proc = (type) alcGetProcAddress(device, #name); \
+		} \
+		return proc; \
+	}
+
+// ALC_SOFT_loopback
+
+typedef ALCdevice *(*walcLoopbackOpenDeviceFunc)(const ALCchar *devicename);
+typedef ALCboolean (*walcIsRenderFormatSupportedFunc)(ALCdevice *device, ALCsizei freq, ALCenum channels, ALCenum type);
+typedef void (*walcRenderSamplesFunc)(ALCdevice *device, ALCvoid *buffer, ALCsizei samples);
+
+WALC_PROC(walcLoopbackOpenDeviceFunc, alcLoopbackOpenDeviceSOFT)
+WALC_PROC(walcIsRenderFormatSupportedFunc, alcIsRenderFormatSupportedSOFT)
+WALC_PROC(walcRenderSamplesFunc, alcRenderSamplesSOFT)
+
+ALCdevice *walcLoopbackOpenDeviceSOFT(void) {
+	walcLoopbackOpenDeviceFunc f = walc_alcLoopbackOpenDeviceSOFT(NULL);
+	if (f == NULL) {
+		return NULL;
+	}
+	return f(NULL);
+}
+
+ALCboolean walcIsRenderFormatSupportedSOFT(ALCdevice *device, ALCsizei freq, ALCenum channels, ALCenum type) {
+	walcIsRenderFormatSupportedFunc f = walc_alcIsRenderFormatSupportedSOFT(device);
+	if (f == NULL) {
+		return ALC_FALSE;
+	}
+	return f(device, freq, channels, type);
+}
+
+void walcRenderSamplesSOFT(ALCdevice *device, void *buffer, ALCsizei samples) {
+	walcRenderSamplesFunc f = walc_alcRenderSamplesSOFT(device);
+	if (f != NULL) {
+		f(device, buffer, samples);
+	}
+}
+
+// ALC_SOFT_HRTF
+
+typedef const ALCchar *(*walcGetStringiFunc)(ALCdevice *device, ALCenum paramName, ALCsizei index);
+typedef ALCboolean (*walcResetDeviceFunc)(ALCdevice *device, const ALCint *attribs);
+
+WALC_PROC(walcGetStringiFunc, alcGetStringiSOFT)
+WALC_PROC(walcResetDeviceFunc, alcResetDeviceSOFT)
+
+const char *walcGetStringiSOFT(ALCdevice *device, ALCenum param, ALCsizei index) {
+	walcGetStringiFunc f = walc_alcGetStringiSOFT(device);
+	if (f == NULL) {
+		return NULL;
+	}
+	return f(device, param, index);
+}
+
+ALCboolean walcResetDeviceSOFT(ALCdevice *device, const ALCint *attribs) {
+	walcResetDeviceFunc f = walc_alcResetDeviceSOFT(device);
+	if (f == NULL) {
+		return ALC_FALSE;
+	}
+	return f(device, attribs);
+}
+
+// ALC_SOFT_reopen_device
+
+typedef ALCboolean (*walcReopenDeviceFunc)(ALCdevice *device, const ALCchar *deviceName, const ALCint *attribs);
+
+WALC_PROC(walcReopenDeviceFunc, alcReopenDeviceSOFT)
+
+ALCboolean walcReopenDeviceSOFT(ALCdevice *device, const char *name, const ALCint *attribs) {
+	walcReopenDeviceFunc f = walc_alcReopenDeviceSOFT(device);
+	if (f == NULL) {
+		return ALC_FALSE;
+	}
+	return f(device, name, attribs);
+}
+
+// ALC_SOFT_system_events
+//
+// Events arrive through a C callback on some thread inside
+// OpenAL, so we register walcEventProcSOFT() below as the
+// callback and hand everything over to the Go side, see
+// walcHandleEvent() in events.go.
+
+typedef void (*walcEventProc)(ALCenum eventType, ALCenum deviceType, ALCdevice *device, ALCsizei length, const ALCchar *message, void *userParam);
+typedef ALCenum (*walcEventIsSupportedFunc)(ALCenum eventType, ALCenum deviceType);
+typedef ALCboolean (*walcEventControlFunc)(ALCsizei count, const ALCenum *events, ALCboolean enable);
+typedef void (*walcEventCallbackFunc)(walcEventProc callback, void *userParam);
+
+WALC_PROC(walcEventIsSupportedFunc, alcEventIsSupportedSOFT)
+WALC_PROC(walcEventControlFunc, alcEventControlSOFT)
+WALC_PROC(walcEventCallbackFunc, alcEventCallbackSOFT)
+
+static void walcEventProcSOFT(ALCenum eventType, ALCenum deviceType, ALCdevice *device, ALCsizei length, const ALCchar *message, void *userParam) {
+	walcHandleEvent(eventType, deviceType, device, length, (char *) message);
+}
+
+ALCenum walcEventIsSupportedSOFT(ALCenum eventType, ALCenum deviceType) {
+	walcEventIsSupportedFunc f = walc_alcEventIsSupportedSOFT(NULL);
+	if (f == NULL) {
+		return 0;
+	}
+	return f(eventType, deviceType);
+}
+
+ALCboolean walcEventControlSOFT(ALCsizei count, const ALCenum *events, ALCboolean enable) {
+	walcEventControlFunc f = walc_alcEventControlSOFT(NULL);
+	if (f == NULL) {
+		return ALC_FALSE;
+	}
+	return f(count, events, enable);
+}
+
+void walcEventCallbackSOFT(void) {
+	walcEventCallbackFunc f = walc_alcEventCallbackSOFT(NULL);
+	if (f != NULL) {
+		f(walcEventProcSOFT, NULL);
+	}
+}
+
+// ALC_SOFT_pause_device
+
+typedef void (*walcDeviceFunc)(ALCdevice *device);
+
+WALC_PROC(walcDeviceFunc, alcDevicePauseSOFT)
+WALC_PROC(walcDeviceFunc, alcDeviceResumeSOFT)
+
+void walcDevicePauseSOFT(ALCdevice *device) {
+	walcDeviceFunc f = walc_alcDevicePauseSOFT(device);
+	if (f != NULL) {
+		f(device);
+	}
+}
+
+void walcDeviceResumeSOFT(ALCdevice *device) {
+	walcDeviceFunc f = walc_alcDeviceResumeSOFT(device);
+	if (f != NULL) {
+		f(device);
+	}
+}
+
+// ALC_SOFT_device_clock
+
+typedef void (*walcGetInteger64vFunc)(ALCdevice *device, ALCenum pname, ALCsizei size, void *values);
+
+WALC_PROC(walcGetInteger64vFunc, alcGetInteger64vSOFT)
+
+void walcGetInteger64vSOFT(ALCdevice *device, ALCenum param, ALCsizei size, void *values) {
+	walcGetInteger64vFunc f = walc_alcGetInteger64vSOFT(device);
+	if (f != NULL) {
+		f(device, param, size, values);
+	}
+}
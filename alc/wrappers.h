@@ -0,0 +1,53 @@
+#ifndef _GO_WRAPPERS_ALC_
+#define _GO_WRAPPERS_ALC_
+
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Prototypes for the wrappers in wrappers.c. Only core.go
+// includes wrappers.c itself, the other cgo files include
+// this header instead so they can call the wrappers too.
+
+ALCdevice *walcOpenDevice(const char *devicename);
+ALCboolean walcIsExtensionPresent(ALCdevice *device, const char *extname);
+ALCenum walcGetEnumValue(ALCdevice *device, const char *enumname);
+const char *walcGetString(ALCdevice *device, ALCenum param);
+void walcGetIntegerv(ALCdevice *device, ALCenum param, ALCsizei size, void *data);
+ALCdevice *walcCaptureOpenDevice(const char *devicename, ALCuint frequency, ALCenum format, ALCsizei buffersize);
+ALCint walcGetInteger(ALCdevice *device, ALCenum param);
+
+// ALC_SOFT_loopback
+
+ALCdevice *walcLoopbackOpenDeviceSOFT(void);
+ALCboolean walcIsRenderFormatSupportedSOFT(ALCdevice *device, ALCsizei freq, ALCenum channels, ALCenum type);
+void walcRenderSamplesSOFT(ALCdevice *device, void *buffer, ALCsizei samples);
+
+// ALC_SOFT_HRTF
+
+const char *walcGetStringiSOFT(ALCdevice *device, ALCenum param, ALCsizei index);
+ALCboolean walcResetDeviceSOFT(ALCdevice *device, const ALCint *attribs);
+
+// ALC_SOFT_reopen_device
+
+ALCboolean walcReopenDeviceSOFT(ALCdevice *device, const char *name, const ALCint *attribs);
+
+// ALC_SOFT_system_events
+
+ALCenum walcEventIsSupportedSOFT(ALCenum eventType, ALCenum deviceType);
+ALCboolean walcEventControlSOFT(ALCsizei count, const ALCenum *events, ALCboolean enable);
+void walcEventCallbackSOFT(void);
+
+// ALC_SOFT_pause_device
+
+void walcDevicePauseSOFT(ALCdevice *device);
+void walcDeviceResumeSOFT(ALCdevice *device);
+
+// ALC_SOFT_device_clock
+
+void walcGetInteger64vSOFT(ALCdevice *device, ALCenum param, ALCsizei size, void *values);
+
+// Exported from events.go.
+void walcHandleEvent(ALCenum eventType, ALCenum deviceType, ALCdevice *device, ALCsizei length, char *message);
+
+#endif
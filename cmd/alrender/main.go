@@ -0,0 +1,208 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Alrender renders a 3d scene through OpenAL into a WAV
+// file without touching any audio device.
+//
+// Usage:
+//
+//	alrender [-o out.wav] [-rate 44100] [-channels 2] scene.json
+//
+// See Scene for the format of scene files. Rendering needs
+// the ALC_SOFT_loopback extension (OpenAL Soft has it).
+package main
+
+import (
+	"flag";
+	"fmt";
+	"os";
+	"openal/al";
+	"openal/alc";
+)
+
+var (
+	output = flag.String("o", "out.wav", "WAV file to write");
+	rate = flag.Int("rate", 44100, "sample rate in Hz");
+	channels = flag.Int("channels", 2, "output channels: 1, 2, 4, 6, 7, or 8");
+	debug = flag.Bool("debug", false, "log OpenAL errors");
+)
+
+var layouts = map[int]int32{
+	1: alc.Mono, 2: alc.Stereo, 4: alc.Quad,
+	6: alc.Surround51, 7: alc.Surround61, 8: alc.Surround71,
+}
+
+// We render in blocks this many times a second and move
+// the listener and start sources in between.
+const blocksPerSecond = 100;
+
+// Without a Duration we give up after this many seconds
+// in case something loops.
+const maxSeconds = 600;
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "alrender: " + format + "\n", args...);
+	os.Exit(1);
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: alrender [flags] scene.json\n");
+		flag.PrintDefaults();
+	};
+	flag.Parse();
+	if flag.NArg() != 1 {
+		flag.Usage();
+		os.Exit(2);
+	}
+	layout, ok := layouts[*channels];
+	if !ok {
+		fail("can't render %d channels", *channels);
+	}
+	if *debug {
+		al.SetDebug(al.DebugLog);
+		alc.SetDebug(alc.DebugLog);
+	}
+
+	scene, err := LoadScene(flag.Arg(0));
+	if err != nil {
+		fail("%v", err);
+	}
+
+	if !alc.IsExtensionPresent("ALC_SOFT_loopback") {
+		fail("OpenAL lacks ALC_SOFT_loopback");
+	}
+	device, err := alc.OpenLoopbackDevice(int32(*rate), layout, alc.Short);
+	if err != nil {
+		fail("can't open loopback device: %v", err);
+	}
+	if !device.IsRenderFormatSupported(int32(*rate), layout, alc.Short) {
+		fail("can't render %d channels at %d Hz", *channels, *rate);
+	}
+	context := device.CreateContext();
+	context.Activate();
+
+	sources, err := setup(scene);
+	if err != nil {
+		fail("%v", err);
+	}
+	data := render(device, scene, sources);
+
+	alc.NullContext.Activate();
+	context.Destroy();
+	device.CloseDevice();
+
+	if err = WriteWAV(*output, *channels, *rate, data); err != nil {
+		fail("%v", err);
+	}
+}
+
+// setup() creates buffers and sources for a scene. Each
+// file is only loaded once no matter how many sources
+// play it.
+func setup(scene *Scene) (sources []al.Source, err error) {
+	buffers := make(map[string]al.Buffer);
+	for _, desc := range scene.Sources {
+		buffer, ok := buffers[desc.File];
+		if !ok {
+			sound, err := ReadWAV(desc.File);
+			if err != nil {
+				return nil, err;
+			}
+			buffer = al.NewBuffer();
+			buffer.SetData(sound.Format, sound.Data, sound.Frequency);
+			buffers[desc.File] = buffer;
+		}
+		source := al.NewSource();
+		source.SetBuffer(buffer);
+		source.SetPosition(desc.Position);
+		source.SetVelocity(desc.Velocity);
+		source.SetDirection(desc.Direction);
+		source.SetLooping(desc.Looping);
+		source.SetSourceRelative(desc.Relative);
+		if desc.Gain != nil {
+			source.SetGain(*desc.Gain);
+		}
+		if desc.Pitch != nil {
+			source.SetPitch(*desc.Pitch);
+		}
+		if desc.ReferenceDistance != nil {
+			source.SetReferenceDistance(*desc.ReferenceDistance);
+		}
+		if desc.MaxDistance != nil {
+			source.SetMaxDistance(*desc.MaxDistance);
+		}
+		if desc.RolloffFactor != nil {
+			source.SetRolloffFactor(*desc.RolloffFactor);
+		}
+		sources = append(sources, source);
+	}
+	if scene.Gain != 0 {
+		al.Listener{}.SetGain(scene.Gain);
+	}
+	return sources, nil;
+}
+
+// render() renders the scene block by block and returns
+// all the samples. Blocks end on the frame closest to each
+// 1/blocksPerSecond of a second, so at rates that don't
+// divide evenly some blocks are a frame longer than others
+// and the time never drifts. We also end a block early on
+// the frame a source starts on, so sources start exactly
+// when the scene says; only the listener moves in steps.
+func render(device *alc.LoopbackDevice, scene *Scene, sources []al.Source) (data []byte) {
+	listener := al.Listener{};
+	started := make([]bool, len(sources));
+	starts := make([]int, len(sources));
+	for i, desc := range scene.Sources {
+		starts[i] = int(float64(desc.Start) * float64(*rate) + 0.5);
+	}
+	frames := int(float64(scene.Duration) * float64(*rate) + 0.5);
+	total := 0;
+	for n := 1; ; {
+		t := float32(total) / float32(*rate);
+		if scene.Duration > 0 && total >= frames {
+			break;
+		}
+		if scene.Duration <= 0 && (done(sources, started) || t >= maxSeconds) {
+			break;
+		}
+		position, velocity, o := scene.ListenerAt(t);
+		listener.SetPosition(position);
+		listener.SetVelocity(velocity);
+		listener.SetOrientation(al.Vector{o[0], o[1], o[2]}, al.Vector{o[3], o[4], o[5]});
+		end := n * *rate / blocksPerSecond;
+		next := end;
+		for i := range sources {
+			switch {
+			case started[i]:
+			case starts[i] <= total:
+				sources[i].Play();
+				started[i] = true;
+			case starts[i] < next:
+				next = starts[i];
+			}
+		}
+		if scene.Duration > 0 && next > frames {
+			next = frames;
+		}
+		data = append(data, device.RenderSamples(next - total)...);
+		total = next;
+		if total == end {
+			n++;
+		}
+	}
+	return;
+}
+
+// done() checks whether all sources have played and
+// stopped again.
+func done(sources []al.Source, started []bool) bool {
+	for i, source := range sources {
+		if !started[i] || source.State() != al.Stopped {
+			return false;
+		}
+	}
+	return true;
+}
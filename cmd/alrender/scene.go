@@ -0,0 +1,140 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json";
+	"errors";
+	"fmt";
+	"io/ioutil";
+	"path/filepath";
+	"openal/al";
+)
+
+// Scene is what alrender renders. Scenes are read from JSON
+// files like this one:
+//
+//	{
+//		"duration": 4.0,
+//		"listener": [
+//			{"time": 0, "position": [-5, 0, 0]},
+//			{"time": 4, "position": [5, 0, 0]}
+//		],
+//		"sources": [
+//			{"file": "welcome.wav", "position": [0, 0, -2], "start": 0.5}
+//		]
+//	}
+//
+// Files are relative to the scene file. Times are in seconds.
+type Scene struct {
+	// How much to render; if zero we stop once all
+	// sources have stopped playing.
+	Duration float32;
+	// Gain for the listener; zero means 1.
+	Gain float32;
+	// Where the listener is when, see Keyframe.
+	Listener []Keyframe;
+	Sources []SourceDesc;
+}
+
+// Keyframe puts the listener somewhere at a given time.
+// Between keyframes we interpolate linearly, before the
+// first and after the last the listener stays put.
+// Orientation is "at" followed by "up" as in OpenAL and
+// defaults to looking down the negative z-axis.
+type Keyframe struct {
+	Time float32;
+	Position al.Vector;
+	Orientation *[6]float32;
+}
+
+// SourceDesc describes one source in a scene.
+type SourceDesc struct {
+	// WAV file to play, see ReadWAV().
+	File string;
+	// When to start playing.
+	Start float32;
+	Position al.Vector;
+	Velocity al.Vector;
+	Direction al.Vector;
+	// Gain and Pitch default to 1.
+	Gain *float32;
+	Pitch *float32;
+	ReferenceDistance *float32;
+	MaxDistance *float32;
+	RolloffFactor *float32;
+	Looping bool;
+	// Position is relative to the listener.
+	Relative bool;
+}
+
+// LoadScene() reads a scene from a JSON file and resolves
+// the source files relative to it.
+func LoadScene(name string) (scene *Scene, err error) {
+	raw, err := ioutil.ReadFile(name);
+	if err != nil {
+		return nil, err;
+	}
+	scene = new(Scene);
+	if err = json.Unmarshal(raw, scene); err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err);
+	}
+	dir := filepath.Dir(name);
+	for i := range scene.Sources {
+		s := &scene.Sources[i];
+		if s.File == "" {
+			return nil, fmt.Errorf("%s: source %d has no file", name, i);
+		}
+		if !filepath.IsAbs(s.File) {
+			s.File = filepath.Join(dir, s.File);
+		}
+	}
+	for i := 1; i < len(scene.Listener); i++ {
+		if scene.Listener[i].Time < scene.Listener[i-1].Time {
+			return nil, errors.New(name + ": listener keyframes out of order");
+		}
+	}
+	return scene, nil;
+}
+
+var defaultOrientation = [6]float32{0, 0, -1, 0, 1, 0};
+
+func (self *Keyframe) orientation() [6]float32 {
+	if self.Orientation == nil {
+		return defaultOrientation;
+	}
+	return *self.Orientation;
+}
+
+// ListenerAt() returns where the listener is at the given
+// time and how fast it's moving.
+func (self *Scene) ListenerAt(t float32) (position, velocity al.Vector, orientation [6]float32) {
+	keys := self.Listener;
+	switch {
+	case len(keys) == 0:
+		return al.Vector{}, al.Vector{}, defaultOrientation;
+	case t <= keys[0].Time:
+		return keys[0].Position, al.Vector{}, keys[0].orientation();
+	case t >= keys[len(keys)-1].Time:
+		last := &keys[len(keys)-1];
+		return last.Position, al.Vector{}, last.orientation();
+	}
+	i := 1;
+	for keys[i].Time < t {
+		i++;
+	}
+	a, b := &keys[i-1], &keys[i];
+	span := b.Time - a.Time;
+	f := (t - a.Time) / span;
+	oa, ob := a.orientation(), b.orientation();
+	for k := 0; k < 3; k++ {
+		position[k] = a.Position[k] + f*(b.Position[k]-a.Position[k]);
+		velocity[k] = (b.Position[k] - a.Position[k]) / span;
+	}
+	for k := 0; k < 6; k++ {
+		orientation[k] = oa[k] + f*(ob[k]-oa[k]);
+	}
+	return;
+}
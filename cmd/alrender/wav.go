@@ -0,0 +1,111 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/binary";
+	"errors";
+	"io/ioutil";
+	"os";
+	"openal/al";
+)
+
+// Sound is plain PCM data ready for Buffer.SetData().
+type Sound struct {
+	Format int32;
+	Frequency int32;
+	Data []byte;
+}
+
+// ReadWAV() reads an uncompressed 8 or 16 bit mono or
+// stereo WAV file, which is all plain OpenAL can take
+// without extensions.
+func ReadWAV(name string) (sound *Sound, err error) {
+	raw, err := ioutil.ReadFile(name);
+	if err != nil {
+		return nil, err;
+	}
+	if len(raw) < 12 || string(raw[0:4]) != "RIFF" || string(raw[8:12]) != "WAVE" {
+		return nil, errors.New(name + ": not a WAV file");
+	}
+	var channels, bits uint16;
+	var frequency uint32;
+	var data []byte;
+	for p := 12; p+8 <= len(raw); {
+		id := string(raw[p:p+4]);
+		size := int(binary.LittleEndian.Uint32(raw[p+4:p+8]));
+		p += 8;
+		if size > len(raw)-p {
+			size = len(raw)-p;
+		}
+		chunk := raw[p:p+size];
+		switch id {
+		case "fmt ":
+			if size < 16 || binary.LittleEndian.Uint16(chunk[0:2]) != 1 {
+				return nil, errors.New(name + ": only PCM WAV files are supported");
+			}
+			channels = binary.LittleEndian.Uint16(chunk[2:4]);
+			frequency = binary.LittleEndian.Uint32(chunk[4:8]);
+			bits = binary.LittleEndian.Uint16(chunk[14:16]);
+		case "data":
+			data = chunk;
+		}
+		// chunks are padded to an even size
+		p += size + size&1;
+	}
+	if data == nil || channels == 0 {
+		return nil, errors.New(name + ": missing fmt or data chunk");
+	}
+	// drop a partial frame at the end, OpenAL won't take it
+	frameSize := int(channels) * int(bits+7) / 8;
+	data = data[0:len(data) - len(data) % frameSize];
+	if len(data) == 0 {
+		return nil, errors.New(name + ": no samples in data chunk");
+	}
+	var format int32;
+	switch {
+	case channels == 1 && bits == 8:
+		format = al.FormatMono8;
+	case channels == 1 && bits == 16:
+		format = al.FormatMono16;
+	case channels == 2 && bits == 8:
+		format = al.FormatStereo8;
+	case channels == 2 && bits == 16:
+		format = al.FormatStereo16;
+	default:
+		return nil, errors.New(name + ": only 8 or 16 bit mono or stereo WAV files are supported");
+	}
+	return &Sound{format, int32(frequency), data}, nil;
+}
+
+// WriteWAV() writes 16 bit PCM data with the given number
+// of channels as a WAV file.
+func WriteWAV(name string, channels int, frequency int, data []byte) error {
+	header := make([]byte, 44);
+	copy(header[0:4], "RIFF");
+	binary.LittleEndian.PutUint32(header[4:8], uint32(36 + len(data)));
+	copy(header[8:16], "WAVEfmt ");
+	binary.LittleEndian.PutUint32(header[16:20], 16);
+	binary.LittleEndian.PutUint16(header[20:22], 1);
+	binary.LittleEndian.PutUint16(header[22:24], uint16(channels));
+	binary.LittleEndian.PutUint32(header[24:28], uint32(frequency));
+	binary.LittleEndian.PutUint32(header[28:32], uint32(frequency * channels * 2));
+	binary.LittleEndian.PutUint16(header[32:34], uint16(channels * 2));
+	binary.LittleEndian.PutUint16(header[34:36], 16);
+	copy(header[36:40], "data");
+	binary.LittleEndian.PutUint32(header[40:44], uint32(len(data)));
+
+	f, err := os.Create(name);
+	if err != nil {
+		return err;
+	}
+	if _, err = f.Write(header); err == nil {
+		_, err = f.Write(data);
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr;
+	}
+	return err;
+}
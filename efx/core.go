@@ -0,0 +1,168 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Go binding for OpenAL's Effects Extension (ALC_EXT_EFX).
+//
+// See the "Effects Extension Guide" that comes with the
+// OpenAL SDK for details about EFX not described here.
+//
+// EFX introduces three new kinds of objects, and we map
+// them to Go types just like openal/al maps sources and
+// buffers:
+//
+//	Effect			an effect like reverb or echo
+//	Filter			a lowpass, highpass or bandpass filter
+//	AuxiliaryEffectSlot	a slot that renders an effect
+//
+// To hear an effect, load it into an AuxiliaryEffectSlot
+// and route a source to that slot with SetAuxiliarySend().
+// Filters can be applied to the "dry" path of a source
+// with SetDirectFilter() or to the "wet" path into a slot
+// with SetAuxiliarySend().
+//
+// Instead of setting effect properties one at a time, we
+// use a struct for each kind of effect: Effect.SetReverb()
+// takes a Reverb and so on. The Default* variables hold
+// the defaults from the EFX specification, so the usual
+// way to set up an effect is to copy one of those and to
+// change whatever needs changing. For reverb there's also
+// a table of presets, see Effect.SetReverbPreset().
+//
+// All of EFX is an extension, so check that your device
+// supports it with alc's Device.IsExtensionPresent() and
+// "ALC_EXT_EFX" before using anything here. If EFX is not
+// available, everything here quietly does nothing.
+//
+// Errors are reported through al.GetError() and in debug
+// mode they are handled just like errors in openal/al,
+// see al.SetDebug().
+package efx
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include "wrapper.h"
+*/
+import "C"
+
+import "openal/al"
+
+const (
+	alFalse = 0;
+	alTrue = 1;
+)
+
+var bool2al map[bool]int32 = map[bool]int32{true: alTrue, false: alFalse}
+
+// Source properties.
+const (
+	alDirectFilter = 0x20005;
+	alAuxiliarySendFilter = 0x20006;
+	alAirAbsorptionFactor = 0x20007;
+	alRoomRolloffFactor = 0x20008;
+	alConeOuterGainHF = 0x20009;
+	alDirectFilterGainHFAuto = 0x2000A;
+	alAuxiliarySendFilterGainAuto = 0x2000B;
+	alAuxiliarySendFilterGainHFAuto = 0x2000C;
+)
+
+// Listener properties.
+const (
+	alMetersPerUnit = 0x20004;
+)
+
+// SetDirectFilter() applies the filter to the direct
+// ("dry") path of the source. Pass al.None as the filter
+// to remove it again.
+func SetDirectFilter(source al.Source, filter Filter) {
+	C.alSourcei(C.ALuint(source), alDirectFilter, C.ALint(filter));
+	if debugging() {
+		debugCheck(source, filter);
+	}
+}
+
+// SetAuxiliarySend() routes the source into the effect
+// slot through the given send, optionally applying the
+// filter to the "wet" path. Sends are numbered from 0 up
+// to (but not including) alc.MaxAuxiliarySends for the
+// context. Pass al.None as the slot to disconnect a send
+// and al.None as the filter for an unfiltered send.
+func SetAuxiliarySend(source al.Source, send int32, slot AuxiliaryEffectSlot, filter Filter) {
+	C.alSource3i(C.ALuint(source), alAuxiliarySendFilter, C.ALint(slot), C.ALint(send), C.ALint(filter));
+	if debugging() {
+		debugCheck(source, send, slot, filter);
+	}
+}
+
+func setSourcef(source al.Source, param int32, value float32) {
+	C.alSourcef(C.ALuint(source), C.ALenum(param), C.ALfloat(value));
+	if debugging() {
+		debugCheck(source, param, value);
+	}
+}
+
+func setSourcei(source al.Source, param int32, value int32) {
+	C.alSourcei(C.ALuint(source), C.ALenum(param), C.ALint(value));
+	if debugging() {
+		debugCheck(source, param, value);
+	}
+}
+
+// SetAirAbsorptionFactor() scales the high frequency
+// attenuation through air for the source.
+func SetAirAbsorptionFactor(source al.Source, factor float32) {
+	setSourcef(source, alAirAbsorptionFactor, factor);
+}
+
+// SetRoomRolloffFactor() is like Source.SetRolloffFactor()
+// but for the reverberated sound of the source.
+func SetRoomRolloffFactor(source al.Source, factor float32) {
+	setSourcef(source, alRoomRolloffFactor, factor);
+}
+
+// SetConeOuterGainHF() is like Source.SetOuterGain() but
+// for high frequencies.
+func SetConeOuterGainHF(source al.Source, gain float32) {
+	setSourcef(source, alConeOuterGainHF, gain);
+}
+
+// SetDirectFilterGainHFAuto() decides whether high
+// frequencies of the direct path are attenuated
+// automatically, for example by the source cone.
+func SetDirectFilterGainHFAuto(source al.Source, yes bool) {
+	setSourcei(source, alDirectFilterGainHFAuto, bool2al[yes]);
+}
+
+// SetAuxiliarySendFilterGainAuto() decides whether the
+// gain of the auxiliary sends is computed automatically
+// from the distance to the listener.
+func SetAuxiliarySendFilterGainAuto(source al.Source, yes bool) {
+	setSourcei(source, alAuxiliarySendFilterGainAuto, bool2al[yes]);
+}
+
+// SetAuxiliarySendFilterGainHFAuto() is the same as
+// SetAuxiliarySendFilterGainAuto() for high frequencies.
+func SetAuxiliarySendFilterGainHFAuto(source al.Source, yes bool) {
+	setSourcei(source, alAuxiliarySendFilterGainHFAuto, bool2al[yes]);
+}
+
+// SetMetersPerUnit() tells EFX how large a unit of the
+// listener's coordinate system is, which matters for air
+// absorption and other effects of distance.
+func SetMetersPerUnit(meters float32) {
+	C.alListenerf(alMetersPerUnit, C.ALfloat(meters));
+	if debugging() {
+		debugCheck(meters);
+	}
+}
+
+// GetMetersPerUnit() returns the current scale, see
+// SetMetersPerUnit().
+func GetMetersPerUnit() float32 {
+	result := float32(C.walGetMetersPerUnit());
+	if debugging() {
+		debugCheck();
+	}
+	return result;
+}
@@ -0,0 +1,28 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Debug mode in pure Go.
+//
+// EFX reports its errors through the AL state machine, so
+// we follow whatever debug mode openal/al is in instead of
+// having a switch of our own. Check openal/al for details.
+
+package efx
+
+import "openal/al"
+
+// debugging() tells whether openal/al is in debug mode.
+func debugging() bool {
+	return al.GetDebug() != al.DebugOff;
+}
+
+// debugCheck() is called after every wrapped call while
+// debug mode is on; args are the arguments of the call.
+func debugCheck(args ...interface{}) {
+	err := al.GetError();
+	if err == nil {
+		return;
+	}
+	al.DebugReport(al.GetDebug(), err, args...);
+}
@@ -0,0 +1,850 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package efx
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include "wrapper.h"
+*/
+import "C"
+import "unsafe"
+
+import "openal/al"
+
+// Effects hold the type and properties of an effect. They
+// only become audible once loaded into an effect slot, see
+// AuxiliaryEffectSlot.SetEffect().
+type Effect uint32;
+
+// Effect properties.
+const (
+	alEffectType = 0x8001;
+)
+
+// Effect types from Effect.GetType().
+const (
+	EffectNull = 0x0000;
+	EffectReverb = 0x0001;
+	EffectChorus = 0x0002;
+	EffectDistortion = 0x0003;
+	EffectEcho = 0x0004;
+	EffectFlanger = 0x0005;
+	EffectFrequencyShifter = 0x0006;
+	EffectVocalMorpher = 0x0007;
+	EffectPitchShifter = 0x0008;
+	EffectRingModulator = 0x0009;
+	EffectAutowah = 0x000A;
+	EffectCompressor = 0x000B;
+	EffectEqualizer = 0x000C;
+	EffectEAXReverb = 0x8000;
+)
+
+// Waveforms for Chorus and Flanger.
+const (
+	WaveformSinusoid = 0;
+	WaveformTriangle = 1;
+)
+
+// Waveforms for RingModulator.
+const (
+	RingModulatorSinusoid = 0;
+	RingModulatorSawtooth = 1;
+	RingModulatorSquare = 2;
+)
+
+// NewEffects() creates n effects.
+// Renamed, was GenEffects.
+func NewEffects(n int) (effects []Effect) {
+	effects = make([]Effect, n);
+	C.walGenEffects(C.ALsizei(n), unsafe.Pointer(&effects[0]));
+	if debugging() {
+		debugCheck(n);
+	}
+	return;
+}
+
+// DeleteEffects() deletes the given effects.
+func DeleteEffects(effects []Effect) {
+	n := len(effects);
+	C.walDeleteEffects(C.ALsizei(n), unsafe.Pointer(&effects[0]));
+	if debugging() {
+		debugCheck(effects);
+	}
+}
+
+// NewEffect() creates a single effect.
+// Convenience function, see NewEffects().
+func NewEffect() Effect {
+	result := Effect(C.walGenEffect());
+	if debugging() {
+		debugCheck();
+	}
+	return result;
+}
+
+// DeleteEffect() deletes a single effect.
+// Convenience function, see DeleteEffects().
+func DeleteEffect(effect Effect) {
+	C.walDeleteEffect(C.ALuint(effect));
+	if debugging() {
+		debugCheck(effect);
+	}
+}
+
+// Renamed, was Effecti.
+func (self Effect) seti(param int32, value int32) {
+	C.walEffecti(C.ALuint(self), C.ALenum(param), C.ALint(value));
+	if debugging() {
+		debugCheck(self, param, value);
+	}
+}
+
+// Renamed, was Effectf.
+func (self Effect) setf(param int32, value float32) {
+	C.walEffectf(C.ALuint(self), C.ALenum(param), C.ALfloat(value));
+	if debugging() {
+		debugCheck(self, param, value);
+	}
+}
+
+// Renamed, was Effectfv.
+func (self Effect) setfv(param int32, values []float32) {
+	C.walEffectfv(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugging() {
+		debugCheck(self, param, values);
+	}
+}
+
+// Renamed, was GetEffecti.
+func (self Effect) geti(param int32) int32 {
+	result := int32(C.walGetEffecti(C.ALuint(self), C.ALenum(param)));
+	if debugging() {
+		debugCheck(self, param);
+	}
+	return result;
+}
+
+// Renamed, was GetEffectf.
+func (self Effect) getf(param int32) float32 {
+	result := float32(C.walGetEffectf(C.ALuint(self), C.ALenum(param)));
+	if debugging() {
+		debugCheck(self, param);
+	}
+	return result;
+}
+
+// Renamed, was GetEffectfv.
+func (self Effect) getfv(param int32, values []float32) {
+	C.walGetEffectfv(C.ALuint(self), C.ALenum(param), unsafe.Pointer(&values[0]));
+	if debugging() {
+		debugCheck(self, param, values);
+	}
+}
+
+// GetType() returns the type of the effect, for example
+// EffectReverb after Effect.SetReverb().
+// Convenience method.
+func (self Effect) GetType() int32 {
+	return self.geti(alEffectType);
+}
+
+///// Reverb /////////////////////////////////////////////////////////
+
+// Reverb properties.
+const (
+	alReverbDensity = 0x0001;
+	alReverbDiffusion = 0x0002;
+	alReverbGain = 0x0003;
+	alReverbGainHF = 0x0004;
+	alReverbDecayTime = 0x0005;
+	alReverbDecayHFRatio = 0x0006;
+	alReverbReflectionsGain = 0x0007;
+	alReverbReflectionsDelay = 0x0008;
+	alReverbLateReverbGain = 0x0009;
+	alReverbLateReverbDelay = 0x000A;
+	alReverbAirAbsorptionGainHF = 0x000B;
+	alReverbRoomRolloffFactor = 0x000C;
+	alReverbDecayHFLimit = 0x000D;
+)
+
+// Reverb is the standard reverb effect.
+type Reverb struct {
+	Density float32; // 0.0 to 1.0
+	Diffusion float32; // 0.0 to 1.0
+	Gain float32; // 0.0 to 1.0
+	GainHF float32; // 0.0 to 1.0
+	DecayTime float32; // 0.1 to 20.0 seconds
+	DecayHFRatio float32; // 0.1 to 2.0
+	ReflectionsGain float32; // 0.0 to 3.16
+	ReflectionsDelay float32; // 0.0 to 0.3 seconds
+	LateReverbGain float32; // 0.0 to 10.0
+	LateReverbDelay float32; // 0.0 to 0.1 seconds
+	AirAbsorptionGainHF float32; // 0.892 to 1.0
+	RoomRolloffFactor float32; // 0.0 to 10.0
+	DecayHFLimit bool;
+}
+
+// DefaultReverb holds the default reverb properties.
+var DefaultReverb = Reverb{
+	Density: 1.0,
+	Diffusion: 1.0,
+	Gain: 0.32,
+	GainHF: 0.89,
+	DecayTime: 1.49,
+	DecayHFRatio: 0.83,
+	ReflectionsGain: 0.05,
+	ReflectionsDelay: 0.007,
+	LateReverbGain: 1.26,
+	LateReverbDelay: 0.011,
+	AirAbsorptionGainHF: 0.994,
+	RoomRolloffFactor: 0.0,
+	DecayHFLimit: true,
+}
+
+// SetReverb() turns the effect into a reverb effect with the given
+// properties.
+func (self Effect) SetReverb(props Reverb) {
+	self.seti(alEffectType, EffectReverb);
+	self.setf(alReverbDensity, props.Density);
+	self.setf(alReverbDiffusion, props.Diffusion);
+	self.setf(alReverbGain, props.Gain);
+	self.setf(alReverbGainHF, props.GainHF);
+	self.setf(alReverbDecayTime, props.DecayTime);
+	self.setf(alReverbDecayHFRatio, props.DecayHFRatio);
+	self.setf(alReverbReflectionsGain, props.ReflectionsGain);
+	self.setf(alReverbReflectionsDelay, props.ReflectionsDelay);
+	self.setf(alReverbLateReverbGain, props.LateReverbGain);
+	self.setf(alReverbLateReverbDelay, props.LateReverbDelay);
+	self.setf(alReverbAirAbsorptionGainHF, props.AirAbsorptionGainHF);
+	self.setf(alReverbRoomRolloffFactor, props.RoomRolloffFactor);
+	self.seti(alReverbDecayHFLimit, bool2al[props.DecayHFLimit]);
+}
+
+// GetReverb() returns the reverb properties of the effect.
+func (self Effect) GetReverb() (props Reverb) {
+	props.Density = self.getf(alReverbDensity);
+	props.Diffusion = self.getf(alReverbDiffusion);
+	props.Gain = self.getf(alReverbGain);
+	props.GainHF = self.getf(alReverbGainHF);
+	props.DecayTime = self.getf(alReverbDecayTime);
+	props.DecayHFRatio = self.getf(alReverbDecayHFRatio);
+	props.ReflectionsGain = self.getf(alReverbReflectionsGain);
+	props.ReflectionsDelay = self.getf(alReverbReflectionsDelay);
+	props.LateReverbGain = self.getf(alReverbLateReverbGain);
+	props.LateReverbDelay = self.getf(alReverbLateReverbDelay);
+	props.AirAbsorptionGainHF = self.getf(alReverbAirAbsorptionGainHF);
+	props.RoomRolloffFactor = self.getf(alReverbRoomRolloffFactor);
+	props.DecayHFLimit = self.geti(alReverbDecayHFLimit) != alFalse;
+	return;
+}
+
+///// EAXReverb //////////////////////////////////////////////////////
+
+// EAXReverb properties.
+const (
+	alEAXReverbDensity = 0x0001;
+	alEAXReverbDiffusion = 0x0002;
+	alEAXReverbGain = 0x0003;
+	alEAXReverbGainHF = 0x0004;
+	alEAXReverbGainLF = 0x0005;
+	alEAXReverbDecayTime = 0x0006;
+	alEAXReverbDecayHFRatio = 0x0007;
+	alEAXReverbDecayLFRatio = 0x0008;
+	alEAXReverbReflectionsGain = 0x0009;
+	alEAXReverbReflectionsDelay = 0x000A;
+	alEAXReverbReflectionsPan = 0x000B;
+	alEAXReverbLateReverbGain = 0x000C;
+	alEAXReverbLateReverbDelay = 0x000D;
+	alEAXReverbLateReverbPan = 0x000E;
+	alEAXReverbEchoTime = 0x000F;
+	alEAXReverbEchoDepth = 0x0010;
+	alEAXReverbModulationTime = 0x0011;
+	alEAXReverbModulationDepth = 0x0012;
+	alEAXReverbAirAbsorptionGainHF = 0x0013;
+	alEAXReverbHFReference = 0x0014;
+	alEAXReverbLFReference = 0x0015;
+	alEAXReverbRoomRolloffFactor = 0x0016;
+	alEAXReverbDecayHFLimit = 0x0017;
+)
+
+// EAXReverb is the EAX reverb effect, a superset of Reverb.
+type EAXReverb struct {
+	Density float32; // 0.0 to 1.0
+	Diffusion float32; // 0.0 to 1.0
+	Gain float32; // 0.0 to 1.0
+	GainHF float32; // 0.0 to 1.0
+	GainLF float32; // 0.0 to 1.0
+	DecayTime float32; // 0.1 to 20.0 seconds
+	DecayHFRatio float32; // 0.1 to 2.0
+	DecayLFRatio float32; // 0.1 to 2.0
+	ReflectionsGain float32; // 0.0 to 3.16
+	ReflectionsDelay float32; // 0.0 to 0.3 seconds
+	ReflectionsPan al.Vector; // vector of length 0.0 to 1.0
+	LateReverbGain float32; // 0.0 to 10.0
+	LateReverbDelay float32; // 0.0 to 0.1 seconds
+	LateReverbPan al.Vector; // vector of length 0.0 to 1.0
+	EchoTime float32; // 0.075 to 0.25 seconds
+	EchoDepth float32; // 0.0 to 1.0
+	ModulationTime float32; // 0.04 to 4.0 seconds
+	ModulationDepth float32; // 0.0 to 1.0
+	AirAbsorptionGainHF float32; // 0.892 to 1.0
+	HFReference float32; // 1000.0 to 20000.0 Hz
+	LFReference float32; // 20.0 to 1000.0 Hz
+	RoomRolloffFactor float32; // 0.0 to 10.0
+	DecayHFLimit bool;
+}
+
+// DefaultEAXReverb holds the default EAX reverb properties.
+var DefaultEAXReverb = EAXReverb{
+	Density: 1.0,
+	Diffusion: 1.0,
+	Gain: 0.32,
+	GainHF: 0.89,
+	GainLF: 1.0,
+	DecayTime: 1.49,
+	DecayHFRatio: 0.83,
+	DecayLFRatio: 1.0,
+	ReflectionsGain: 0.05,
+	ReflectionsDelay: 0.007,
+	ReflectionsPan: al.Vector{0.0, 0.0, 0.0},
+	LateReverbGain: 1.26,
+	LateReverbDelay: 0.011,
+	LateReverbPan: al.Vector{0.0, 0.0, 0.0},
+	EchoTime: 0.25,
+	EchoDepth: 0.0,
+	ModulationTime: 0.25,
+	ModulationDepth: 0.0,
+	AirAbsorptionGainHF: 0.994,
+	HFReference: 5000.0,
+	LFReference: 250.0,
+	RoomRolloffFactor: 0.0,
+	DecayHFLimit: true,
+}
+
+// SetEAXReverb() turns the effect into a EAX reverb effect with the given
+// properties.
+func (self Effect) SetEAXReverb(props EAXReverb) {
+	self.seti(alEffectType, EffectEAXReverb);
+	self.setf(alEAXReverbDensity, props.Density);
+	self.setf(alEAXReverbDiffusion, props.Diffusion);
+	self.setf(alEAXReverbGain, props.Gain);
+	self.setf(alEAXReverbGainHF, props.GainHF);
+	self.setf(alEAXReverbGainLF, props.GainLF);
+	self.setf(alEAXReverbDecayTime, props.DecayTime);
+	self.setf(alEAXReverbDecayHFRatio, props.DecayHFRatio);
+	self.setf(alEAXReverbDecayLFRatio, props.DecayLFRatio);
+	self.setf(alEAXReverbReflectionsGain, props.ReflectionsGain);
+	self.setf(alEAXReverbReflectionsDelay, props.ReflectionsDelay);
+	self.setfv(alEAXReverbReflectionsPan, props.ReflectionsPan[0:]);
+	self.setf(alEAXReverbLateReverbGain, props.LateReverbGain);
+	self.setf(alEAXReverbLateReverbDelay, props.LateReverbDelay);
+	self.setfv(alEAXReverbLateReverbPan, props.LateReverbPan[0:]);
+	self.setf(alEAXReverbEchoTime, props.EchoTime);
+	self.setf(alEAXReverbEchoDepth, props.EchoDepth);
+	self.setf(alEAXReverbModulationTime, props.ModulationTime);
+	self.setf(alEAXReverbModulationDepth, props.ModulationDepth);
+	self.setf(alEAXReverbAirAbsorptionGainHF, props.AirAbsorptionGainHF);
+	self.setf(alEAXReverbHFReference, props.HFReference);
+	self.setf(alEAXReverbLFReference, props.LFReference);
+	self.setf(alEAXReverbRoomRolloffFactor, props.RoomRolloffFactor);
+	self.seti(alEAXReverbDecayHFLimit, bool2al[props.DecayHFLimit]);
+}
+
+// GetEAXReverb() returns the EAX reverb properties of the effect.
+func (self Effect) GetEAXReverb() (props EAXReverb) {
+	props.Density = self.getf(alEAXReverbDensity);
+	props.Diffusion = self.getf(alEAXReverbDiffusion);
+	props.Gain = self.getf(alEAXReverbGain);
+	props.GainHF = self.getf(alEAXReverbGainHF);
+	props.GainLF = self.getf(alEAXReverbGainLF);
+	props.DecayTime = self.getf(alEAXReverbDecayTime);
+	props.DecayHFRatio = self.getf(alEAXReverbDecayHFRatio);
+	props.DecayLFRatio = self.getf(alEAXReverbDecayLFRatio);
+	props.ReflectionsGain = self.getf(alEAXReverbReflectionsGain);
+	props.ReflectionsDelay = self.getf(alEAXReverbReflectionsDelay);
+	self.getfv(alEAXReverbReflectionsPan, props.ReflectionsPan[0:]);
+	props.LateReverbGain = self.getf(alEAXReverbLateReverbGain);
+	props.LateReverbDelay = self.getf(alEAXReverbLateReverbDelay);
+	self.getfv(alEAXReverbLateReverbPan, props.LateReverbPan[0:]);
+	props.EchoTime = self.getf(alEAXReverbEchoTime);
+	props.EchoDepth = self.getf(alEAXReverbEchoDepth);
+	props.ModulationTime = self.getf(alEAXReverbModulationTime);
+	props.ModulationDepth = self.getf(alEAXReverbModulationDepth);
+	props.AirAbsorptionGainHF = self.getf(alEAXReverbAirAbsorptionGainHF);
+	props.HFReference = self.getf(alEAXReverbHFReference);
+	props.LFReference = self.getf(alEAXReverbLFReference);
+	props.RoomRolloffFactor = self.getf(alEAXReverbRoomRolloffFactor);
+	props.DecayHFLimit = self.geti(alEAXReverbDecayHFLimit) != alFalse;
+	return;
+}
+
+///// Chorus /////////////////////////////////////////////////////////
+
+// Chorus properties.
+const (
+	alChorusWaveform = 0x0001;
+	alChorusPhase = 0x0002;
+	alChorusRate = 0x0003;
+	alChorusDepth = 0x0004;
+	alChorusFeedback = 0x0005;
+	alChorusDelay = 0x0006;
+)
+
+// Chorus is the chorus effect.
+type Chorus struct {
+	Waveform int32; // WaveformSinusoid or WaveformTriangle
+	Phase int32; // -180 to 180 degrees
+	Rate float32; // 0.0 to 10.0 Hz
+	Depth float32; // 0.0 to 1.0
+	Feedback float32; // -1.0 to 1.0
+	Delay float32; // 0.0 to 0.016 seconds
+}
+
+// DefaultChorus holds the default chorus properties.
+var DefaultChorus = Chorus{
+	Waveform: WaveformTriangle,
+	Phase: 90,
+	Rate: 1.1,
+	Depth: 0.1,
+	Feedback: 0.25,
+	Delay: 0.016,
+}
+
+// SetChorus() turns the effect into a chorus effect with the given
+// properties.
+func (self Effect) SetChorus(props Chorus) {
+	self.seti(alEffectType, EffectChorus);
+	self.seti(alChorusWaveform, props.Waveform);
+	self.seti(alChorusPhase, props.Phase);
+	self.setf(alChorusRate, props.Rate);
+	self.setf(alChorusDepth, props.Depth);
+	self.setf(alChorusFeedback, props.Feedback);
+	self.setf(alChorusDelay, props.Delay);
+}
+
+// GetChorus() returns the chorus properties of the effect.
+func (self Effect) GetChorus() (props Chorus) {
+	props.Waveform = self.geti(alChorusWaveform);
+	props.Phase = self.geti(alChorusPhase);
+	props.Rate = self.getf(alChorusRate);
+	props.Depth = self.getf(alChorusDepth);
+	props.Feedback = self.getf(alChorusFeedback);
+	props.Delay = self.getf(alChorusDelay);
+	return;
+}
+
+///// Distortion /////////////////////////////////////////////////////
+
+// Distortion properties.
+const (
+	alDistortionEdge = 0x0001;
+	alDistortionGain = 0x0002;
+	alDistortionLowpassCutoff = 0x0003;
+	alDistortionEQCenter = 0x0004;
+	alDistortionEQBandwidth = 0x0005;
+)
+
+// Distortion is the distortion effect.
+type Distortion struct {
+	Edge float32; // 0.0 to 1.0
+	Gain float32; // 0.01 to 1.0
+	LowpassCutoff float32; // 80.0 to 24000.0 Hz
+	EQCenter float32; // 80.0 to 24000.0 Hz
+	EQBandwidth float32; // 80.0 to 24000.0 Hz
+}
+
+// DefaultDistortion holds the default distortion properties.
+var DefaultDistortion = Distortion{
+	Edge: 0.2,
+	Gain: 0.05,
+	LowpassCutoff: 8000.0,
+	EQCenter: 3600.0,
+	EQBandwidth: 3600.0,
+}
+
+// SetDistortion() turns the effect into a distortion effect with the given
+// properties.
+func (self Effect) SetDistortion(props Distortion) {
+	self.seti(alEffectType, EffectDistortion);
+	self.setf(alDistortionEdge, props.Edge);
+	self.setf(alDistortionGain, props.Gain);
+	self.setf(alDistortionLowpassCutoff, props.LowpassCutoff);
+	self.setf(alDistortionEQCenter, props.EQCenter);
+	self.setf(alDistortionEQBandwidth, props.EQBandwidth);
+}
+
+// GetDistortion() returns the distortion properties of the effect.
+func (self Effect) GetDistortion() (props Distortion) {
+	props.Edge = self.getf(alDistortionEdge);
+	props.Gain = self.getf(alDistortionGain);
+	props.LowpassCutoff = self.getf(alDistortionLowpassCutoff);
+	props.EQCenter = self.getf(alDistortionEQCenter);
+	props.EQBandwidth = self.getf(alDistortionEQBandwidth);
+	return;
+}
+
+///// Echo ///////////////////////////////////////////////////////////
+
+// Echo properties.
+const (
+	alEchoDelay = 0x0001;
+	alEchoLRDelay = 0x0002;
+	alEchoDamping = 0x0003;
+	alEchoFeedback = 0x0004;
+	alEchoSpread = 0x0005;
+)
+
+// Echo is the echo effect.
+type Echo struct {
+	Delay float32; // 0.0 to 0.207 seconds
+	LRDelay float32; // 0.0 to 0.404 seconds
+	Damping float32; // 0.0 to 0.99
+	Feedback float32; // 0.0 to 1.0
+	Spread float32; // -1.0 to 1.0
+}
+
+// DefaultEcho holds the default echo properties.
+var DefaultEcho = Echo{
+	Delay: 0.1,
+	LRDelay: 0.1,
+	Damping: 0.5,
+	Feedback: 0.5,
+	Spread: -1.0,
+}
+
+// SetEcho() turns the effect into a echo effect with the given
+// properties.
+func (self Effect) SetEcho(props Echo) {
+	self.seti(alEffectType, EffectEcho);
+	self.setf(alEchoDelay, props.Delay);
+	self.setf(alEchoLRDelay, props.LRDelay);
+	self.setf(alEchoDamping, props.Damping);
+	self.setf(alEchoFeedback, props.Feedback);
+	self.setf(alEchoSpread, props.Spread);
+}
+
+// GetEcho() returns the echo properties of the effect.
+func (self Effect) GetEcho() (props Echo) {
+	props.Delay = self.getf(alEchoDelay);
+	props.LRDelay = self.getf(alEchoLRDelay);
+	props.Damping = self.getf(alEchoDamping);
+	props.Feedback = self.getf(alEchoFeedback);
+	props.Spread = self.getf(alEchoSpread);
+	return;
+}
+
+///// Flanger ////////////////////////////////////////////////////////
+
+// Flanger properties.
+const (
+	alFlangerWaveform = 0x0001;
+	alFlangerPhase = 0x0002;
+	alFlangerRate = 0x0003;
+	alFlangerDepth = 0x0004;
+	alFlangerFeedback = 0x0005;
+	alFlangerDelay = 0x0006;
+)
+
+// Flanger is the flanger effect.
+type Flanger struct {
+	Waveform int32; // WaveformSinusoid or WaveformTriangle
+	Phase int32; // -180 to 180 degrees
+	Rate float32; // 0.0 to 10.0 Hz
+	Depth float32; // 0.0 to 1.0
+	Feedback float32; // -1.0 to 1.0
+	Delay float32; // 0.0 to 0.004 seconds
+}
+
+// DefaultFlanger holds the default flanger properties.
+var DefaultFlanger = Flanger{
+	Waveform: WaveformTriangle,
+	Phase: 0,
+	Rate: 0.27,
+	Depth: 1.0,
+	Feedback: -0.5,
+	Delay: 0.002,
+}
+
+// SetFlanger() turns the effect into a flanger effect with the given
+// properties.
+func (self Effect) SetFlanger(props Flanger) {
+	self.seti(alEffectType, EffectFlanger);
+	self.seti(alFlangerWaveform, props.Waveform);
+	self.seti(alFlangerPhase, props.Phase);
+	self.setf(alFlangerRate, props.Rate);
+	self.setf(alFlangerDepth, props.Depth);
+	self.setf(alFlangerFeedback, props.Feedback);
+	self.setf(alFlangerDelay, props.Delay);
+}
+
+// GetFlanger() returns the flanger properties of the effect.
+func (self Effect) GetFlanger() (props Flanger) {
+	props.Waveform = self.geti(alFlangerWaveform);
+	props.Phase = self.geti(alFlangerPhase);
+	props.Rate = self.getf(alFlangerRate);
+	props.Depth = self.getf(alFlangerDepth);
+	props.Feedback = self.getf(alFlangerFeedback);
+	props.Delay = self.getf(alFlangerDelay);
+	return;
+}
+
+///// PitchShifter ///////////////////////////////////////////////////
+
+// PitchShifter properties.
+const (
+	alPitchShifterCoarseTune = 0x0001;
+	alPitchShifterFineTune = 0x0002;
+)
+
+// PitchShifter is the pitch shifter effect.
+type PitchShifter struct {
+	CoarseTune int32; // -12 to 12 semitones
+	FineTune int32; // -50 to 50 cents
+}
+
+// DefaultPitchShifter holds the default pitch shifter properties.
+var DefaultPitchShifter = PitchShifter{
+	CoarseTune: 12,
+	FineTune: 0,
+}
+
+// SetPitchShifter() turns the effect into a pitch shifter effect with the given
+// properties.
+func (self Effect) SetPitchShifter(props PitchShifter) {
+	self.seti(alEffectType, EffectPitchShifter);
+	self.seti(alPitchShifterCoarseTune, props.CoarseTune);
+	self.seti(alPitchShifterFineTune, props.FineTune);
+}
+
+// GetPitchShifter() returns the pitch shifter properties of the effect.
+func (self Effect) GetPitchShifter() (props PitchShifter) {
+	props.CoarseTune = self.geti(alPitchShifterCoarseTune);
+	props.FineTune = self.geti(alPitchShifterFineTune);
+	return;
+}
+
+///// RingModulator //////////////////////////////////////////////////
+
+// RingModulator properties.
+const (
+	alRingModulatorFrequency = 0x0001;
+	alRingModulatorHighpassCutoff = 0x0002;
+	alRingModulatorWaveform = 0x0003;
+)
+
+// RingModulator is the ring modulator effect.
+type RingModulator struct {
+	Frequency float32; // 0.0 to 8000.0 Hz
+	HighpassCutoff float32; // 0.0 to 24000.0 Hz
+	Waveform int32; // RingModulatorSinusoid, RingModulatorSawtooth or RingModulatorSquare
+}
+
+// DefaultRingModulator holds the default ring modulator properties.
+var DefaultRingModulator = RingModulator{
+	Frequency: 440.0,
+	HighpassCutoff: 800.0,
+	Waveform: RingModulatorSinusoid,
+}
+
+// SetRingModulator() turns the effect into a ring modulator effect with the given
+// properties.
+func (self Effect) SetRingModulator(props RingModulator) {
+	self.seti(alEffectType, EffectRingModulator);
+	self.setf(alRingModulatorFrequency, props.Frequency);
+	self.setf(alRingModulatorHighpassCutoff, props.HighpassCutoff);
+	self.seti(alRingModulatorWaveform, props.Waveform);
+}
+
+// GetRingModulator() returns the ring modulator properties of the effect.
+func (self Effect) GetRingModulator() (props RingModulator) {
+	props.Frequency = self.getf(alRingModulatorFrequency);
+	props.HighpassCutoff = self.getf(alRingModulatorHighpassCutoff);
+	props.Waveform = self.geti(alRingModulatorWaveform);
+	return;
+}
+
+///// Autowah ////////////////////////////////////////////////////////
+
+// Autowah properties.
+const (
+	alAutowahAttackTime = 0x0001;
+	alAutowahReleaseTime = 0x0002;
+	alAutowahResonance = 0x0003;
+	alAutowahPeakGain = 0x0004;
+)
+
+// Autowah is the auto-wah effect.
+type Autowah struct {
+	AttackTime float32; // 0.0001 to 1.0 seconds
+	ReleaseTime float32; // 0.0001 to 1.0 seconds
+	Resonance float32; // 2.0 to 1000.0
+	PeakGain float32; // 0.00003 to 31621.0
+}
+
+// DefaultAutowah holds the default autowah properties.
+var DefaultAutowah = Autowah{
+	AttackTime: 0.06,
+	ReleaseTime: 0.06,
+	Resonance: 1000.0,
+	PeakGain: 11.22,
+}
+
+// SetAutowah() turns the effect into a autowah effect with the given
+// properties.
+func (self Effect) SetAutowah(props Autowah) {
+	self.seti(alEffectType, EffectAutowah);
+	self.setf(alAutowahAttackTime, props.AttackTime);
+	self.setf(alAutowahReleaseTime, props.ReleaseTime);
+	self.setf(alAutowahResonance, props.Resonance);
+	self.setf(alAutowahPeakGain, props.PeakGain);
+}
+
+// GetAutowah() returns the autowah properties of the effect.
+func (self Effect) GetAutowah() (props Autowah) {
+	props.AttackTime = self.getf(alAutowahAttackTime);
+	props.ReleaseTime = self.getf(alAutowahReleaseTime);
+	props.Resonance = self.getf(alAutowahResonance);
+	props.PeakGain = self.getf(alAutowahPeakGain);
+	return;
+}
+
+///// Compressor /////////////////////////////////////////////////////
+
+// Compressor properties.
+const (
+	alCompressorOnOff = 0x0001;
+)
+
+// Compressor is the compressor effect.
+type Compressor struct {
+	OnOff bool;
+}
+
+// DefaultCompressor holds the default compressor properties.
+var DefaultCompressor = Compressor{
+	OnOff: true,
+}
+
+// SetCompressor() turns the effect into a compressor effect with the given
+// properties.
+func (self Effect) SetCompressor(props Compressor) {
+	self.seti(alEffectType, EffectCompressor);
+	self.seti(alCompressorOnOff, bool2al[props.OnOff]);
+}
+
+// GetCompressor() returns the compressor properties of the effect.
+func (self Effect) GetCompressor() (props Compressor) {
+	props.OnOff = self.geti(alCompressorOnOff) != alFalse;
+	return;
+}
+
+///// Equalizer //////////////////////////////////////////////////////
+
+// Equalizer properties.
+const (
+	alEqualizerLowGain = 0x0001;
+	alEqualizerLowCutoff = 0x0002;
+	alEqualizerMid1Gain = 0x0003;
+	alEqualizerMid1Center = 0x0004;
+	alEqualizerMid1Width = 0x0005;
+	alEqualizerMid2Gain = 0x0006;
+	alEqualizerMid2Center = 0x0007;
+	alEqualizerMid2Width = 0x0008;
+	alEqualizerHighGain = 0x0009;
+	alEqualizerHighCutoff = 0x000A;
+)
+
+// Equalizer is the four band equalizer effect.
+type Equalizer struct {
+	LowGain float32; // 0.126 to 7.943
+	LowCutoff float32; // 50.0 to 800.0 Hz
+	Mid1Gain float32; // 0.126 to 7.943
+	Mid1Center float32; // 200.0 to 3000.0 Hz
+	Mid1Width float32; // 0.01 to 1.0
+	Mid2Gain float32; // 0.126 to 7.943
+	Mid2Center float32; // 1000.0 to 8000.0 Hz
+	Mid2Width float32; // 0.01 to 1.0
+	HighGain float32; // 0.126 to 7.943
+	HighCutoff float32; // 4000.0 to 16000.0 Hz
+}
+
+// DefaultEqualizer holds the default equalizer properties.
+var DefaultEqualizer = Equalizer{
+	LowGain: 1.0,
+	LowCutoff: 200.0,
+	Mid1Gain: 1.0,
+	Mid1Center: 500.0,
+	Mid1Width: 1.0,
+	Mid2Gain: 1.0,
+	Mid2Center: 3000.0,
+	Mid2Width: 1.0,
+	HighGain: 1.0,
+	HighCutoff: 6000.0,
+}
+
+// SetEqualizer() turns the effect into a equalizer effect with the given
+// properties.
+func (self Effect) SetEqualizer(props Equalizer) {
+	self.seti(alEffectType, EffectEqualizer);
+	self.setf(alEqualizerLowGain, props.LowGain);
+	self.setf(alEqualizerLowCutoff, props.LowCutoff);
+	self.setf(alEqualizerMid1Gain, props.Mid1Gain);
+	self.setf(alEqualizerMid1Center, props.Mid1Center);
+	self.setf(alEqualizerMid1Width, props.Mid1Width);
+	self.setf(alEqualizerMid2Gain, props.Mid2Gain);
+	self.setf(alEqualizerMid2Center, props.Mid2Center);
+	self.setf(alEqualizerMid2Width, props.Mid2Width);
+	self.setf(alEqualizerHighGain, props.HighGain);
+	self.setf(alEqualizerHighCutoff, props.HighCutoff);
+}
+
+// GetEqualizer() returns the equalizer properties of the effect.
+func (self Effect) GetEqualizer() (props Equalizer) {
+	props.LowGain = self.getf(alEqualizerLowGain);
+	props.LowCutoff = self.getf(alEqualizerLowCutoff);
+	props.Mid1Gain = self.getf(alEqualizerMid1Gain);
+	props.Mid1Center = self.getf(alEqualizerMid1Center);
+	props.Mid1Width = self.getf(alEqualizerMid1Width);
+	props.Mid2Gain = self.getf(alEqualizerMid2Gain);
+	props.Mid2Center = self.getf(alEqualizerMid2Center);
+	props.Mid2Width = self.getf(alEqualizerMid2Width);
+	props.HighGain = self.getf(alEqualizerHighGain);
+	props.HighCutoff = self.getf(alEqualizerHighCutoff);
+	return;
+}
+
+// Reverb() drops the properties only EAX reverb has, see
+// Effect.SetReverbPreset().
+func (self EAXReverb) Reverb() Reverb {
+	return Reverb{
+		Density: self.Density,
+		Diffusion: self.Diffusion,
+		Gain: self.Gain,
+		GainHF: self.GainHF,
+		DecayTime: self.DecayTime,
+		DecayHFRatio: self.DecayHFRatio,
+		ReflectionsGain: self.ReflectionsGain,
+		ReflectionsDelay: self.ReflectionsDelay,
+		LateReverbGain: self.LateReverbGain,
+		LateReverbDelay: self.LateReverbDelay,
+		AirAbsorptionGainHF: self.AirAbsorptionGainHF,
+		RoomRolloffFactor: self.RoomRolloffFactor,
+		DecayHFLimit: self.DecayHFLimit,
+	};
+}
+
+// SetReverbPreset() applies a preset, see presets.go, in
+// one call. If the implementation has no EAX reverb, it
+// falls back to standard reverb, which sounds close enough
+// for most presets.
+// Convenience method, see Effect.SetEAXReverb().
+func (self Effect) SetReverbPreset(preset EAXReverb) {
+	if al.GetEnumValue("AL_EFFECT_EAXREVERB") != 0 {
+		self.SetEAXReverb(preset);
+	} else {
+		self.SetReverb(preset.Reverb());
+	}
+}
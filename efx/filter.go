@@ -0,0 +1,224 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package efx
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include "wrapper.h"
+*/
+import "C"
+import "unsafe"
+
+// Filters change the frequency response of the sound
+// going through them, see SetDirectFilter() and
+// SetAuxiliarySend().
+type Filter uint32;
+
+// Filter properties.
+const (
+	alFilterType = 0x8001;
+)
+
+// Filter types from Filter.GetType().
+const (
+	FilterNull = 0x0000;
+	FilterLowpass = 0x0001;
+	FilterHighpass = 0x0002;
+	FilterBandpass = 0x0003;
+)
+
+// NewFilters() creates n filters.
+// Renamed, was GenFilters.
+func NewFilters(n int) (filters []Filter) {
+	filters = make([]Filter, n);
+	C.walGenFilters(C.ALsizei(n), unsafe.Pointer(&filters[0]));
+	if debugging() {
+		debugCheck(n);
+	}
+	return;
+}
+
+// DeleteFilters() deletes the given filters.
+func DeleteFilters(filters []Filter) {
+	n := len(filters);
+	C.walDeleteFilters(C.ALsizei(n), unsafe.Pointer(&filters[0]));
+	if debugging() {
+		debugCheck(filters);
+	}
+}
+
+// NewFilter() creates a single filter.
+// Convenience function, see NewFilters().
+func NewFilter() Filter {
+	result := Filter(C.walGenFilter());
+	if debugging() {
+		debugCheck();
+	}
+	return result;
+}
+
+// DeleteFilter() deletes a single filter.
+// Convenience function, see DeleteFilters().
+func DeleteFilter(filter Filter) {
+	C.walDeleteFilter(C.ALuint(filter));
+	if debugging() {
+		debugCheck(filter);
+	}
+}
+
+// Renamed, was Filteri.
+func (self Filter) seti(param int32, value int32) {
+	C.walFilteri(C.ALuint(self), C.ALenum(param), C.ALint(value));
+	if debugging() {
+		debugCheck(self, param, value);
+	}
+}
+
+// Renamed, was Filterf.
+func (self Filter) setf(param int32, value float32) {
+	C.walFilterf(C.ALuint(self), C.ALenum(param), C.ALfloat(value));
+	if debugging() {
+		debugCheck(self, param, value);
+	}
+}
+
+// Renamed, was GetFilteri.
+func (self Filter) geti(param int32) int32 {
+	result := int32(C.walGetFilteri(C.ALuint(self), C.ALenum(param)));
+	if debugging() {
+		debugCheck(self, param);
+	}
+	return result;
+}
+
+// Renamed, was GetFilterf.
+func (self Filter) getf(param int32) float32 {
+	result := float32(C.walGetFilterf(C.ALuint(self), C.ALenum(param)));
+	if debugging() {
+		debugCheck(self, param);
+	}
+	return result;
+}
+
+// GetType() returns the type of the filter, for example
+// FilterLowpass after Filter.SetLowpass().
+// Convenience method.
+func (self Filter) GetType() int32 {
+	return self.geti(alFilterType);
+}
+
+///// Lowpass ////////////////////////////////////////////////////////
+
+// Lowpass properties.
+const (
+	alLowpassGain = 0x0001;
+	alLowpassGainHF = 0x0002;
+)
+
+// Lowpass filters attenuate high frequencies, which makes
+// sounds muffled.
+type Lowpass struct {
+	Gain float32; // 0.0 to 1.0
+	GainHF float32; // 0.0 to 1.0
+}
+
+// DefaultLowpass holds the default lowpass properties.
+var DefaultLowpass = Lowpass{
+	Gain: 1.0,
+	GainHF: 1.0,
+}
+
+// SetLowpass() turns the filter into a lowpass filter with
+// the given properties.
+func (self Filter) SetLowpass(props Lowpass) {
+	self.seti(alFilterType, FilterLowpass);
+	self.setf(alLowpassGain, props.Gain);
+	self.setf(alLowpassGainHF, props.GainHF);
+}
+
+// GetLowpass() returns the lowpass properties of the filter.
+func (self Filter) GetLowpass() (props Lowpass) {
+	props.Gain = self.getf(alLowpassGain);
+	props.GainHF = self.getf(alLowpassGainHF);
+	return;
+}
+
+///// Highpass ///////////////////////////////////////////////////////
+
+// Highpass properties.
+const (
+	alHighpassGain = 0x0001;
+	alHighpassGainLF = 0x0002;
+)
+
+// Highpass filters attenuate low frequencies.
+type Highpass struct {
+	Gain float32; // 0.0 to 1.0
+	GainLF float32; // 0.0 to 1.0
+}
+
+// DefaultHighpass holds the default highpass properties.
+var DefaultHighpass = Highpass{
+	Gain: 1.0,
+	GainLF: 1.0,
+}
+
+// SetHighpass() turns the filter into a highpass filter
+// with the given properties.
+func (self Filter) SetHighpass(props Highpass) {
+	self.seti(alFilterType, FilterHighpass);
+	self.setf(alHighpassGain, props.Gain);
+	self.setf(alHighpassGainLF, props.GainLF);
+}
+
+// GetHighpass() returns the highpass properties of the
+// filter.
+func (self Filter) GetHighpass() (props Highpass) {
+	props.Gain = self.getf(alHighpassGain);
+	props.GainLF = self.getf(alHighpassGainLF);
+	return;
+}
+
+///// Bandpass ///////////////////////////////////////////////////////
+
+// Bandpass properties.
+const (
+	alBandpassGain = 0x0001;
+	alBandpassGainLF = 0x0002;
+	alBandpassGainHF = 0x0003;
+)
+
+// Bandpass filters attenuate both low and high frequencies.
+type Bandpass struct {
+	Gain float32; // 0.0 to 1.0
+	GainLF float32; // 0.0 to 1.0
+	GainHF float32; // 0.0 to 1.0
+}
+
+// DefaultBandpass holds the default bandpass properties.
+var DefaultBandpass = Bandpass{
+	Gain: 1.0,
+	GainLF: 1.0,
+	GainHF: 1.0,
+}
+
+// SetBandpass() turns the filter into a bandpass filter
+// with the given properties.
+func (self Filter) SetBandpass(props Bandpass) {
+	self.seti(alFilterType, FilterBandpass);
+	self.setf(alBandpassGain, props.Gain);
+	self.setf(alBandpassGainLF, props.GainLF);
+	self.setf(alBandpassGainHF, props.GainHF);
+}
+
+// GetBandpass() returns the bandpass properties of the
+// filter.
+func (self Filter) GetBandpass() (props Bandpass) {
+	props.Gain = self.getf(alBandpassGain);
+	props.GainLF = self.getf(alBandpassGainLF);
+	props.GainHF = self.getf(alBandpassGainHF);
+	return;
+}
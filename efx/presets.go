@@ -0,0 +1,875 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Reverb presets in pure Go.
+//
+// These are the presets from the efx-presets.h header
+// that comes with OpenAL Soft, transcribed into EAXReverb
+// values: first the classic EAX environments, then the
+// castle, factory, ice palace, space station, wooden
+// galleon, sports, prefab, dome and pipe, outdoors, mood,
+// driving, city and miscellaneous groups. The fields are
+// in the same order as in the header, so new presets
+// can be added by copying their numbers over. Use
+// Effect.SetReverbPreset() to apply one, or look one
+// up by name with LookupPreset().
+
+package efx
+
+import "strings"
+
+import "openal/al"
+
+var PresetGeneric = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.8913, 1.0000, 1.4900, 0.8300, 1.0000, 0.0500, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 1.2589, 0.0110, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetPaddedCell = EAXReverb{
+	0.1715, 1.0000, 0.3162, 0.0010, 1.0000, 0.1700, 0.1000, 1.0000, 0.2500, 0.0010,
+	al.Vector{0.0, 0.0, 0.0}, 1.2691, 0.0020, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetRoom = EAXReverb{
+	0.4287, 1.0000, 0.3162, 0.5929, 1.0000, 0.4000, 0.8300, 1.0000, 0.1503, 0.0020,
+	al.Vector{0.0, 0.0, 0.0}, 1.0629, 0.0030, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetBathroom = EAXReverb{
+	0.1715, 1.0000, 0.3162, 0.2512, 1.0000, 1.4900, 0.5400, 1.0000, 0.6531, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 3.2734, 0.0110, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetLivingRoom = EAXReverb{
+	0.9766, 1.0000, 0.3162, 0.0010, 1.0000, 0.5000, 0.1000, 1.0000, 0.2051, 0.0030,
+	al.Vector{0.0, 0.0, 0.0}, 0.2805, 0.0040, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetStoneRoom = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.7079, 1.0000, 2.3100, 0.6400, 1.0000, 0.4411, 0.0120,
+	al.Vector{0.0, 0.0, 0.0}, 1.1003, 0.0170, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetAuditorium = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.5781, 1.0000, 4.3200, 0.5900, 1.0000, 0.4032, 0.0200,
+	al.Vector{0.0, 0.0, 0.0}, 0.7170, 0.0300, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetConcertHall = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.5623, 1.0000, 3.9200, 0.7000, 1.0000, 0.2427, 0.0200,
+	al.Vector{0.0, 0.0, 0.0}, 0.9977, 0.0290, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetCave = EAXReverb{
+	1.0000, 1.0000, 0.3162, 1.0000, 1.0000, 2.9100, 1.3000, 1.0000, 0.5000, 0.0150,
+	al.Vector{0.0, 0.0, 0.0}, 0.7063, 0.0220, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, false,
+}
+
+var PresetArena = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.4477, 1.0000, 7.2400, 0.3300, 1.0000, 0.2612, 0.0200,
+	al.Vector{0.0, 0.0, 0.0}, 1.0186, 0.0300, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetHangar = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.3162, 1.0000, 10.0500, 0.2300, 1.0000, 0.5000, 0.0200,
+	al.Vector{0.0, 0.0, 0.0}, 1.2560, 0.0300, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetCarpetedHallway = EAXReverb{
+	0.4287, 1.0000, 0.3162, 0.0100, 1.0000, 0.3000, 0.1000, 1.0000, 0.1215, 0.0020,
+	al.Vector{0.0, 0.0, 0.0}, 0.1531, 0.0300, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetHallway = EAXReverb{
+	0.3645, 1.0000, 0.3162, 0.7079, 1.0000, 1.4900, 0.5900, 1.0000, 0.2458, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 1.6615, 0.0110, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetStoneCorridor = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.7612, 1.0000, 2.7000, 0.7900, 1.0000, 0.2472, 0.0130,
+	al.Vector{0.0, 0.0, 0.0}, 1.5758, 0.0200, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetAlley = EAXReverb{
+	1.0000, 0.3000, 0.3162, 0.7328, 1.0000, 1.4900, 0.8600, 1.0000, 0.2500, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 0.9954, 0.0110, al.Vector{0.0, 0.0, 0.0},
+	0.1250, 0.9500, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetForest = EAXReverb{
+	1.0000, 0.3000, 0.3162, 0.0224, 1.0000, 1.4900, 0.5400, 1.0000, 0.0525, 0.1620,
+	al.Vector{0.0, 0.0, 0.0}, 0.7682, 0.0880, al.Vector{0.0, 0.0, 0.0},
+	0.1250, 1.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetCity = EAXReverb{
+	1.0000, 0.5000, 0.3162, 0.3981, 1.0000, 1.4900, 0.6700, 1.0000, 0.0730, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 0.1427, 0.0110, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetMountains = EAXReverb{
+	1.0000, 0.2700, 0.3162, 0.0562, 1.0000, 1.4900, 0.2100, 1.0000, 0.0407, 0.3000,
+	al.Vector{0.0, 0.0, 0.0}, 0.1919, 0.1000, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 1.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, false,
+}
+
+var PresetQuarry = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.3162, 1.0000, 1.4900, 0.8300, 1.0000, 0.0000, 0.0610,
+	al.Vector{0.0, 0.0, 0.0}, 1.7783, 0.0250, al.Vector{0.0, 0.0, 0.0},
+	0.1250, 0.7000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetPlain = EAXReverb{
+	1.0000, 0.2100, 0.3162, 0.1000, 1.0000, 1.4900, 0.5000, 1.0000, 0.0585, 0.1790,
+	al.Vector{0.0, 0.0, 0.0}, 0.1089, 0.1000, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 1.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetParkingLot = EAXReverb{
+	1.0000, 1.0000, 0.3162, 1.0000, 1.0000, 1.6500, 1.5000, 1.0000, 0.2082, 0.0080,
+	al.Vector{0.0, 0.0, 0.0}, 0.2652, 0.0120, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, false,
+}
+
+var PresetSewerPipe = EAXReverb{
+	0.3071, 0.8000, 0.3162, 0.3162, 1.0000, 2.8100, 0.1400, 1.0000, 1.6387, 0.0140,
+	al.Vector{0.0, 0.0, 0.0}, 3.2471, 0.0210, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetUnderwater = EAXReverb{
+	0.3645, 1.0000, 0.3162, 0.0100, 1.0000, 1.4900, 0.1000, 1.0000, 0.5963, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 7.0795, 0.0110, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 1.1800, 0.3480, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetDrugged = EAXReverb{
+	0.4287, 0.5000, 0.3162, 1.0000, 1.0000, 8.3900, 1.3900, 1.0000, 0.8760, 0.0020,
+	al.Vector{0.0, 0.0, 0.0}, 3.1081, 0.0300, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 1.0000, 0.9943, 5000.0000, 250.0000, 0.0000, false,
+}
+
+var PresetDizzy = EAXReverb{
+	0.3645, 0.6000, 0.3162, 0.6310, 1.0000, 17.2300, 0.5600, 1.0000, 0.1392, 0.0200,
+	al.Vector{0.0, 0.0, 0.0}, 0.4937, 0.0300, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 1.0000, 0.8100, 0.3100, 0.9943, 5000.0000, 250.0000, 0.0000, false,
+}
+
+var PresetPsychotic = EAXReverb{
+	0.0625, 0.5000, 0.3162, 0.8404, 1.0000, 7.5600, 0.9100, 1.0000, 0.4864, 0.0200,
+	al.Vector{0.0, 0.0, 0.0}, 2.4378, 0.0300, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 4.0000, 1.0000, 0.9943, 5000.0000, 250.0000, 0.0000, false,
+}
+
+///// Castle /////////////////////////////////////////////////////////
+
+var PresetCastleSmallRoom = EAXReverb{
+	1.0000, 0.8900, 0.3162, 0.3981, 0.1000, 1.2200, 0.8300, 0.3100, 0.8913, 0.0220,
+	al.Vector{0.0, 0.0, 0.0}, 1.9953, 0.0110, al.Vector{0.0, 0.0, 0.0},
+	0.1380, 0.0800, 0.2500, 0.0000, 0.9943, 5168.6001, 139.5000, 0.0000, true,
+}
+
+var PresetCastleShortPassage = EAXReverb{
+	1.0000, 0.8900, 0.3162, 0.3162, 0.1000, 2.3200, 0.8300, 0.3100, 0.8913, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 1.2589, 0.0230, al.Vector{0.0, 0.0, 0.0},
+	0.1380, 0.0800, 0.2500, 0.0000, 0.9943, 5168.6001, 139.5000, 0.0000, true,
+}
+
+var PresetCastleMediumRoom = EAXReverb{
+	1.0000, 0.9300, 0.3162, 0.2818, 0.1000, 2.0400, 0.8300, 0.4600, 0.6310, 0.0220,
+	al.Vector{0.0, 0.0, 0.0}, 1.5849, 0.0110, al.Vector{0.0, 0.0, 0.0},
+	0.1550, 0.0300, 0.2500, 0.0000, 0.9943, 5168.6001, 139.5000, 0.0000, true,
+}
+
+var PresetCastleLargeRoom = EAXReverb{
+	1.0000, 0.8200, 0.3162, 0.2818, 0.1259, 2.5300, 0.8300, 0.5000, 0.4467, 0.0340,
+	al.Vector{0.0, 0.0, 0.0}, 1.2589, 0.0160, al.Vector{0.0, 0.0, 0.0},
+	0.1850, 0.0700, 0.2500, 0.0000, 0.9943, 5168.6001, 139.5000, 0.0000, true,
+}
+
+var PresetCastleLongPassage = EAXReverb{
+	1.0000, 0.8900, 0.3162, 0.3981, 0.1000, 3.4200, 0.8300, 0.3100, 0.8913, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 1.4125, 0.0230, al.Vector{0.0, 0.0, 0.0},
+	0.1380, 0.0800, 0.2500, 0.0000, 0.9943, 5168.6001, 139.5000, 0.0000, true,
+}
+
+var PresetCastleHall = EAXReverb{
+	1.0000, 0.8100, 0.3162, 0.2818, 0.1778, 3.1400, 0.7900, 0.6200, 0.1778, 0.0560,
+	al.Vector{0.0, 0.0, 0.0}, 1.1220, 0.0240, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5168.6001, 139.5000, 0.0000, true,
+}
+
+var PresetCastleCupboard = EAXReverb{
+	1.0000, 0.8900, 0.3162, 0.2818, 0.1000, 0.6700, 0.8700, 0.3100, 1.4125, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 3.5481, 0.0070, al.Vector{0.0, 0.0, 0.0},
+	0.1380, 0.0800, 0.2500, 0.0000, 0.9943, 5168.6001, 139.5000, 0.0000, true,
+}
+
+var PresetCastleCourtyard = EAXReverb{
+	1.0000, 0.4200, 0.3162, 0.4467, 0.1995, 2.1300, 0.6100, 0.2300, 0.2239, 0.1600,
+	al.Vector{0.0, 0.0, 0.0}, 0.7079, 0.0360, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.3700, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, false,
+}
+
+var PresetCastleAlcove = EAXReverb{
+	1.0000, 0.8900, 0.3162, 0.5012, 0.1000, 1.6400, 0.8700, 0.3100, 1.0000, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 1.4125, 0.0340, al.Vector{0.0, 0.0, 0.0},
+	0.1380, 0.0800, 0.2500, 0.0000, 0.9943, 5168.6001, 139.5000, 0.0000, true,
+}
+
+///// Factory ////////////////////////////////////////////////////////
+
+var PresetFactorySmallRoom = EAXReverb{
+	0.3645, 0.8200, 0.3162, 0.7943, 0.5012, 1.7200, 0.6500, 1.3100, 0.7079, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 1.7783, 0.0240, al.Vector{0.0, 0.0, 0.0},
+	0.1190, 0.0700, 0.2500, 0.0000, 0.9943, 3762.6001, 362.5000, 0.0000, true,
+}
+
+var PresetFactoryShortPassage = EAXReverb{
+	0.3645, 0.6400, 0.2512, 0.7943, 0.5012, 2.5300, 0.6500, 1.3100, 1.0000, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 1.2589, 0.0380, al.Vector{0.0, 0.0, 0.0},
+	0.1350, 0.2300, 0.2500, 0.0000, 0.9943, 3762.6001, 362.5000, 0.0000, true,
+}
+
+var PresetFactoryMediumRoom = EAXReverb{
+	0.4287, 0.8200, 0.2512, 0.7943, 0.5012, 2.7600, 0.6500, 1.3100, 0.2818, 0.0220,
+	al.Vector{0.0, 0.0, 0.0}, 1.4125, 0.0230, al.Vector{0.0, 0.0, 0.0},
+	0.1740, 0.0700, 0.2500, 0.0000, 0.9943, 3762.6001, 362.5000, 0.0000, true,
+}
+
+var PresetFactoryLargeRoom = EAXReverb{
+	0.4287, 0.7500, 0.2512, 0.7079, 0.6310, 4.2400, 0.5100, 1.3100, 0.1778, 0.0390,
+	al.Vector{0.0, 0.0, 0.0}, 1.1220, 0.0230, al.Vector{0.0, 0.0, 0.0},
+	0.2310, 0.0700, 0.2500, 0.0000, 0.9943, 3762.6001, 362.5000, 0.0000, true,
+}
+
+var PresetFactoryLongPassage = EAXReverb{
+	0.3645, 0.6400, 0.2512, 0.7943, 0.5012, 4.0600, 0.6500, 1.3100, 1.0000, 0.0200,
+	al.Vector{0.0, 0.0, 0.0}, 1.2589, 0.0370, al.Vector{0.0, 0.0, 0.0},
+	0.1350, 0.2300, 0.2500, 0.0000, 0.9943, 3762.6001, 362.5000, 0.0000, true,
+}
+
+var PresetFactoryHall = EAXReverb{
+	0.4287, 0.7500, 0.3162, 0.7079, 0.6310, 7.4300, 0.5100, 1.3100, 0.0631, 0.0730,
+	al.Vector{0.0, 0.0, 0.0}, 0.8913, 0.0270, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0700, 0.2500, 0.0000, 0.9943, 3762.6001, 362.5000, 0.0000, true,
+}
+
+var PresetFactoryCupboard = EAXReverb{
+	0.3071, 0.6300, 0.2512, 0.7943, 0.5012, 0.4900, 0.6500, 1.3100, 1.2589, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 1.9953, 0.0320, al.Vector{0.0, 0.0, 0.0},
+	0.1070, 0.0700, 0.2500, 0.0000, 0.9943, 3762.6001, 362.5000, 0.0000, true,
+}
+
+var PresetFactoryCourtyard = EAXReverb{
+	0.3071, 0.5700, 0.3162, 0.3162, 0.6310, 2.3200, 0.2900, 0.5600, 0.2239, 0.1400,
+	al.Vector{0.0, 0.0, 0.0}, 0.3981, 0.0390, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.2900, 0.2500, 0.0000, 0.9943, 3762.6001, 362.5000, 0.0000, true,
+}
+
+var PresetFactoryAlcove = EAXReverb{
+	0.3645, 0.5900, 0.2512, 0.7943, 0.5012, 3.1400, 0.6500, 1.3100, 1.4125, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 1.0000, 0.0380, al.Vector{0.0, 0.0, 0.0},
+	0.1140, 0.1000, 0.2500, 0.0000, 0.9943, 3762.6001, 362.5000, 0.0000, true,
+}
+
+///// Ice palace /////////////////////////////////////////////////////
+
+var PresetIcePalaceSmallRoom = EAXReverb{
+	1.0000, 0.8400, 0.3162, 0.5623, 0.2818, 1.5100, 1.5300, 0.2700, 0.8913, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 1.4125, 0.0110, al.Vector{0.0, 0.0, 0.0},
+	0.1640, 0.1400, 0.2500, 0.0000, 0.9943, 12428.5000, 99.6000, 0.0000, true,
+}
+
+var PresetIcePalaceShortPassage = EAXReverb{
+	1.0000, 0.7500, 0.3162, 0.5623, 0.2818, 1.7900, 1.4600, 0.2800, 0.5012, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 1.1220, 0.0190, al.Vector{0.0, 0.0, 0.0},
+	0.1770, 0.0900, 0.2500, 0.0000, 0.9943, 12428.5000, 99.6000, 0.0000, true,
+}
+
+var PresetIcePalaceMediumRoom = EAXReverb{
+	1.0000, 0.8700, 0.3162, 0.5623, 0.4467, 2.2200, 1.5300, 0.3200, 0.3981, 0.0390,
+	al.Vector{0.0, 0.0, 0.0}, 1.1220, 0.0270, al.Vector{0.0, 0.0, 0.0},
+	0.1860, 0.1200, 0.2500, 0.0000, 0.9943, 12428.5000, 99.6000, 0.0000, true,
+}
+
+var PresetIcePalaceLargeRoom = EAXReverb{
+	1.0000, 0.8100, 0.3162, 0.5623, 0.4467, 3.1400, 1.5300, 0.3200, 0.2512, 0.0390,
+	al.Vector{0.0, 0.0, 0.0}, 1.0000, 0.0270, al.Vector{0.0, 0.0, 0.0},
+	0.2140, 0.1100, 0.2500, 0.0000, 0.9943, 12428.5000, 99.6000, 0.0000, true,
+}
+
+var PresetIcePalaceLongPassage = EAXReverb{
+	1.0000, 0.7700, 0.3162, 0.5623, 0.3981, 3.0100, 1.4600, 0.2800, 0.7943, 0.0120,
+	al.Vector{0.0, 0.0, 0.0}, 1.2589, 0.0250, al.Vector{0.0, 0.0, 0.0},
+	0.1860, 0.0400, 0.2500, 0.0000, 0.9943, 12428.5000, 99.6000, 0.0000, true,
+}
+
+var PresetIcePalaceHall = EAXReverb{
+	1.0000, 0.7600, 0.3162, 0.4467, 0.5623, 5.4900, 1.5300, 0.3800, 0.1122, 0.0540,
+	al.Vector{0.0, 0.0, 0.0}, 0.6310, 0.0520, al.Vector{0.0, 0.0, 0.0},
+	0.2260, 0.1100, 0.2500, 0.0000, 0.9943, 12428.5000, 99.6000, 0.0000, true,
+}
+
+var PresetIcePalaceCupboard = EAXReverb{
+	1.0000, 0.8300, 0.3162, 0.5012, 0.2239, 0.7600, 1.5300, 0.2600, 1.1220, 0.0120,
+	al.Vector{0.0, 0.0, 0.0}, 1.9953, 0.0160, al.Vector{0.0, 0.0, 0.0},
+	0.1430, 0.0800, 0.2500, 0.0000, 0.9943, 12428.5000, 99.6000, 0.0000, true,
+}
+
+var PresetIcePalaceCourtyard = EAXReverb{
+	1.0000, 0.5900, 0.3162, 0.2818, 0.3162, 2.0400, 1.2000, 0.3800, 0.3162, 0.1730,
+	al.Vector{0.0, 0.0, 0.0}, 0.3162, 0.0430, al.Vector{0.0, 0.0, 0.0},
+	0.2350, 0.4800, 0.2500, 0.0000, 0.9943, 12428.5000, 99.6000, 0.0000, true,
+}
+
+var PresetIcePalaceAlcove = EAXReverb{
+	1.0000, 0.8400, 0.3162, 0.5623, 0.2818, 2.7600, 1.4600, 0.2800, 1.1220, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 0.8913, 0.0300, al.Vector{0.0, 0.0, 0.0},
+	0.1610, 0.0900, 0.2500, 0.0000, 0.9943, 12428.5000, 99.6000, 0.0000, true,
+}
+
+///// Space station //////////////////////////////////////////////////
+
+var PresetSpaceStationSmallRoom = EAXReverb{
+	0.2109, 0.7000, 0.3162, 0.7079, 0.8913, 1.7200, 0.8200, 0.5500, 0.7943, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 1.4125, 0.0130, al.Vector{0.0, 0.0, 0.0},
+	0.1880, 0.2600, 0.2500, 0.0000, 0.9943, 3316.1001, 458.2000, 0.0000, true,
+}
+
+var PresetSpaceStationShortPassage = EAXReverb{
+	0.2109, 0.8700, 0.3162, 0.6310, 0.8913, 3.5700, 0.5000, 0.5500, 1.0000, 0.0120,
+	al.Vector{0.0, 0.0, 0.0}, 1.1220, 0.0160, al.Vector{0.0, 0.0, 0.0},
+	0.1720, 0.2000, 0.2500, 0.0000, 0.9943, 3316.1001, 458.2000, 0.0000, true,
+}
+
+var PresetSpaceStationMediumRoom = EAXReverb{
+	0.2109, 0.7500, 0.3162, 0.6310, 0.8913, 3.0100, 0.5000, 0.5500, 0.3981, 0.0340,
+	al.Vector{0.0, 0.0, 0.0}, 1.1220, 0.0350, al.Vector{0.0, 0.0, 0.0},
+	0.2090, 0.3100, 0.2500, 0.0000, 0.9943, 3316.1001, 458.2000, 0.0000, true,
+}
+
+var PresetSpaceStationLargeRoom = EAXReverb{
+	0.3645, 0.8100, 0.3162, 0.6310, 0.8913, 3.8900, 0.3800, 0.6100, 0.3162, 0.0560,
+	al.Vector{0.0, 0.0, 0.0}, 0.8913, 0.0350, al.Vector{0.0, 0.0, 0.0},
+	0.2330, 0.2800, 0.2500, 0.0000, 0.9943, 3316.1001, 458.2000, 0.0000, true,
+}
+
+var PresetSpaceStationLongPassage = EAXReverb{
+	0.4287, 0.8200, 0.3162, 0.6310, 0.8913, 4.6200, 0.6200, 0.5500, 1.0000, 0.0120,
+	al.Vector{0.0, 0.0, 0.0}, 1.2589, 0.0310, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.2300, 0.2500, 0.0000, 0.9943, 3316.1001, 458.2000, 0.0000, true,
+}
+
+var PresetSpaceStationHall = EAXReverb{
+	0.4287, 0.8700, 0.3162, 0.6310, 0.8913, 7.1100, 0.3800, 0.6100, 0.1778, 0.1000,
+	al.Vector{0.0, 0.0, 0.0}, 0.6310, 0.0470, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.2500, 0.2500, 0.0000, 0.9943, 3316.1001, 458.2000, 0.0000, true,
+}
+
+var PresetSpaceStationCupboard = EAXReverb{
+	0.1715, 0.5600, 0.3162, 0.7079, 0.8913, 0.7900, 0.8100, 0.5500, 1.4125, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 1.7783, 0.0180, al.Vector{0.0, 0.0, 0.0},
+	0.1810, 0.3100, 0.2500, 0.0000, 0.9943, 3316.1001, 458.2000, 0.0000, true,
+}
+
+var PresetSpaceStationAlcove = EAXReverb{
+	0.2109, 0.7800, 0.3162, 0.7079, 0.8913, 1.1600, 0.8100, 0.5500, 1.4125, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 1.0000, 0.0180, al.Vector{0.0, 0.0, 0.0},
+	0.1920, 0.2100, 0.2500, 0.0000, 0.9943, 3316.1001, 458.2000, 0.0000, true,
+}
+
+///// Wooden galleon /////////////////////////////////////////////////
+
+var PresetWoodenSmallRoom = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.1122, 0.3162, 0.7900, 0.3200, 0.8700, 1.0000, 0.0320,
+	al.Vector{0.0, 0.0, 0.0}, 0.8913, 0.0290, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 4705.0000, 99.6000, 0.0000, true,
+}
+
+var PresetWoodenShortPassage = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.1259, 0.3162, 1.7500, 0.5000, 0.8700, 0.8913, 0.0120,
+	al.Vector{0.0, 0.0, 0.0}, 0.6310, 0.0240, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 4705.0000, 99.6000, 0.0000, true,
+}
+
+var PresetWoodenMediumRoom = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.1000, 0.2818, 1.4700, 0.4200, 0.8200, 0.8913, 0.0490,
+	al.Vector{0.0, 0.0, 0.0}, 0.8913, 0.0290, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 4705.0000, 99.6000, 0.0000, true,
+}
+
+var PresetWoodenLargeRoom = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.0891, 0.2818, 2.6500, 0.3300, 0.8200, 0.8913, 0.0660,
+	al.Vector{0.0, 0.0, 0.0}, 0.7943, 0.0490, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 4705.0000, 99.6000, 0.0000, true,
+}
+
+var PresetWoodenLongPassage = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.1000, 0.3162, 1.9900, 0.4000, 0.7900, 1.0000, 0.0200,
+	al.Vector{0.0, 0.0, 0.0}, 0.4467, 0.0360, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 4705.0000, 99.6000, 0.0000, true,
+}
+
+var PresetWoodenHall = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.0794, 0.2818, 3.4500, 0.3000, 0.8200, 0.8913, 0.0880,
+	al.Vector{0.0, 0.0, 0.0}, 0.7943, 0.0630, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 4705.0000, 99.6000, 0.0000, true,
+}
+
+var PresetWoodenCupboard = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.1413, 0.3162, 0.5600, 0.4600, 0.9100, 1.1220, 0.0120,
+	al.Vector{0.0, 0.0, 0.0}, 1.1220, 0.0280, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 4705.0000, 99.6000, 0.0000, true,
+}
+
+var PresetWoodenCourtyard = EAXReverb{
+	1.0000, 0.6500, 0.3162, 0.0794, 0.3162, 1.7900, 0.3500, 0.7900, 0.5623, 0.1230,
+	al.Vector{0.0, 0.0, 0.0}, 0.1000, 0.0320, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 4705.0000, 99.6000, 0.0000, true,
+}
+
+var PresetWoodenAlcove = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.1259, 0.3162, 1.2200, 0.6200, 0.9100, 1.1220, 0.0120,
+	al.Vector{0.0, 0.0, 0.0}, 0.7079, 0.0240, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 4705.0000, 99.6000, 0.0000, true,
+}
+
+///// Sports /////////////////////////////////////////////////////////
+
+var PresetSportEmptyStadium = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.4467, 0.7943, 6.2600, 0.5100, 1.1000, 0.0631, 0.1830,
+	al.Vector{0.0, 0.0, 0.0}, 0.3981, 0.0380, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetSportSquashCourt = EAXReverb{
+	1.0000, 0.7500, 0.3162, 0.3162, 0.7943, 2.2200, 0.9100, 1.1600, 0.4467, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 0.7943, 0.0110, al.Vector{0.0, 0.0, 0.0},
+	0.1260, 0.1900, 0.2500, 0.0000, 0.9943, 7176.8999, 211.2000, 0.0000, true,
+}
+
+var PresetSportSmallSwimmingPool = EAXReverb{
+	1.0000, 0.7000, 0.3162, 0.7943, 0.8913, 2.7600, 1.2500, 1.1400, 0.6310, 0.0200,
+	al.Vector{0.0, 0.0, 0.0}, 0.7943, 0.0300, al.Vector{0.0, 0.0, 0.0},
+	0.1790, 0.1500, 0.8950, 0.1900, 0.9943, 5000.0000, 250.0000, 0.0000, false,
+}
+
+var PresetSportLargeSwimmingPool = EAXReverb{
+	1.0000, 0.8200, 0.3162, 0.7943, 1.0000, 5.4900, 1.3100, 1.1400, 0.4467, 0.0390,
+	al.Vector{0.0, 0.0, 0.0}, 0.5012, 0.0490, al.Vector{0.0, 0.0, 0.0},
+	0.2220, 0.5500, 1.1590, 0.2100, 0.9943, 5000.0000, 250.0000, 0.0000, false,
+}
+
+var PresetSportGymnasium = EAXReverb{
+	1.0000, 0.8100, 0.3162, 0.4467, 0.8913, 3.1400, 1.0600, 1.3500, 0.3981, 0.0290,
+	al.Vector{0.0, 0.0, 0.0}, 0.5623, 0.0450, al.Vector{0.0, 0.0, 0.0},
+	0.1460, 0.1400, 0.2500, 0.0000, 0.9943, 7176.8999, 211.2000, 0.0000, true,
+}
+
+var PresetSportFullStadium = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.0708, 0.7943, 5.2500, 0.1700, 0.8000, 0.1000, 0.1880,
+	al.Vector{0.0, 0.0, 0.0}, 0.2818, 0.0380, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetSportStadiumTannoy = EAXReverb{
+	1.0000, 0.7800, 0.3162, 0.5623, 0.5012, 2.5300, 0.8800, 0.6800, 0.2818, 0.2300,
+	al.Vector{0.0, 0.0, 0.0}, 0.5012, 0.0630, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.2000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+///// Prefab /////////////////////////////////////////////////////////
+
+var PresetPrefabWorkshop = EAXReverb{
+	0.4287, 1.0000, 0.3162, 0.1413, 0.3981, 0.7600, 1.0000, 1.0000, 1.0000, 0.0120,
+	al.Vector{0.0, 0.0, 0.0}, 1.1220, 0.0120, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, false,
+}
+
+var PresetPrefabSchoolRoom = EAXReverb{
+	0.4022, 0.6900, 0.3162, 0.6310, 0.5012, 0.9800, 0.4500, 0.1800, 1.4125, 0.0170,
+	al.Vector{0.0, 0.0, 0.0}, 1.4125, 0.0150, al.Vector{0.0, 0.0, 0.0},
+	0.0950, 0.1400, 0.2500, 0.0000, 0.9943, 7176.8999, 211.2000, 0.0000, true,
+}
+
+var PresetPrefabPractiseRoom = EAXReverb{
+	0.4022, 0.8700, 0.3162, 0.3981, 0.5012, 1.1200, 0.5600, 0.1800, 1.2589, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 1.4125, 0.0110, al.Vector{0.0, 0.0, 0.0},
+	0.0950, 0.1400, 0.2500, 0.0000, 0.9943, 7176.8999, 211.2000, 0.0000, true,
+}
+
+var PresetPrefabOuthouse = EAXReverb{
+	1.0000, 0.8200, 0.3162, 0.1122, 0.1585, 1.3800, 0.3800, 0.3500, 0.8913, 0.0240,
+	al.Vector{0.0, 0.0, 0.0}, 0.6310, 0.0440, al.Vector{0.0, 0.0, 0.0},
+	0.1210, 0.1700, 0.2500, 0.0000, 0.9943, 2854.3999, 107.5000, 0.0000, false,
+}
+
+var PresetPrefabCaravan = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.0891, 0.1259, 0.4300, 1.5000, 1.0000, 1.0000, 0.0120,
+	al.Vector{0.0, 0.0, 0.0}, 1.9953, 0.0120, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, false,
+}
+
+///// Dome and pipe //////////////////////////////////////////////////
+
+var PresetDomeTomb = EAXReverb{
+	1.0000, 0.7900, 0.3162, 0.3548, 0.2239, 4.1800, 0.2100, 0.1000, 0.3868, 0.0300,
+	al.Vector{0.0, 0.0, 0.0}, 1.6788, 0.0220, al.Vector{0.0, 0.0, 0.0},
+	0.1770, 0.1900, 0.2500, 0.0000, 0.9943, 2854.3999, 20.0000, 0.0000, false,
+}
+
+var PresetPipeSmall = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.3548, 0.2239, 5.0400, 0.1000, 0.1000, 0.5012, 0.0320,
+	al.Vector{0.0, 0.0, 0.0}, 2.5119, 0.0150, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 2854.3999, 20.0000, 0.0000, true,
+}
+
+var PresetDomeSaintPauls = EAXReverb{
+	1.0000, 0.8700, 0.3162, 0.3548, 0.2239, 10.4800, 0.1900, 0.1000, 0.1778, 0.0900,
+	al.Vector{0.0, 0.0, 0.0}, 1.2589, 0.0420, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.1200, 0.2500, 0.0000, 0.9943, 2854.3999, 20.0000, 0.0000, true,
+}
+
+var PresetPipeLongThin = EAXReverb{
+	0.2560, 0.9100, 0.3162, 0.4467, 0.2818, 9.2100, 0.1800, 0.1000, 0.7079, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 0.7079, 0.0220, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 2854.3999, 20.0000, 0.0000, false,
+}
+
+var PresetPipeLarge = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.3548, 0.2239, 8.4500, 0.1000, 0.1000, 0.3981, 0.0460,
+	al.Vector{0.0, 0.0, 0.0}, 1.5849, 0.0320, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 2854.3999, 20.0000, 0.0000, true,
+}
+
+var PresetPipeResonant = EAXReverb{
+	0.1373, 0.9100, 0.3162, 0.4467, 0.2818, 6.8100, 0.1800, 0.1000, 0.7079, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 1.0000, 0.0220, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 2854.3999, 20.0000, 0.0000, false,
+}
+
+///// Outdoors ///////////////////////////////////////////////////////
+
+var PresetOutdoorsBackyard = EAXReverb{
+	1.0000, 0.4500, 0.3162, 0.2512, 0.5012, 1.1200, 0.3400, 0.4600, 0.4467, 0.0690,
+	al.Vector{0.0, 0.0, 0.0}, 0.7079, 0.0230, al.Vector{0.0, 0.0, 0.0},
+	0.2180, 0.3400, 0.2500, 0.0000, 0.9943, 4399.1001, 242.9000, 0.0000, false,
+}
+
+var PresetOutdoorsRollingPlains = EAXReverb{
+	1.0000, 0.0000, 0.3162, 0.0112, 0.6310, 2.1300, 0.2100, 0.4600, 0.1778, 0.3000,
+	al.Vector{0.0, 0.0, 0.0}, 0.4467, 0.0190, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 1.0000, 0.2500, 0.0000, 0.9943, 4399.1001, 242.9000, 0.0000, false,
+}
+
+var PresetOutdoorsDeepCanyon = EAXReverb{
+	1.0000, 0.7400, 0.3162, 0.1778, 0.6310, 3.8900, 0.2100, 0.4600, 0.3162, 0.2230,
+	al.Vector{0.0, 0.0, 0.0}, 0.3548, 0.0190, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 1.0000, 0.2500, 0.0000, 0.9943, 4399.1001, 242.9000, 0.0000, false,
+}
+
+var PresetOutdoorsCreek = EAXReverb{
+	1.0000, 0.3500, 0.3162, 0.1778, 0.5012, 2.1300, 0.2100, 0.4600, 0.3981, 0.1150,
+	al.Vector{0.0, 0.0, 0.0}, 0.1995, 0.0310, al.Vector{0.0, 0.0, 0.0},
+	0.2180, 0.3400, 0.2500, 0.0000, 0.9943, 4399.1001, 242.9000, 0.0000, false,
+}
+
+var PresetOutdoorsValley = EAXReverb{
+	1.0000, 0.2800, 0.3162, 0.0282, 0.1585, 2.8800, 0.2600, 0.3500, 0.1413, 0.2630,
+	al.Vector{0.0, 0.0, 0.0}, 0.3981, 0.1000, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.3400, 0.2500, 0.0000, 0.9943, 2854.3999, 107.5000, 0.0000, false,
+}
+
+///// Mood ///////////////////////////////////////////////////////////
+
+var PresetMoodHeaven = EAXReverb{
+	1.0000, 0.9400, 0.3162, 0.7943, 0.4467, 5.0400, 1.1200, 0.5600, 0.2427, 0.0200,
+	al.Vector{0.0, 0.0, 0.0}, 1.2589, 0.0290, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0800, 2.7420, 0.0500, 0.9977, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetMoodHell = EAXReverb{
+	1.0000, 0.5700, 0.3162, 0.3548, 0.4467, 3.5700, 0.4900, 2.0000, 0.0000, 0.0200,
+	al.Vector{0.0, 0.0, 0.0}, 1.4125, 0.0300, al.Vector{0.0, 0.0, 0.0},
+	0.1100, 0.0400, 2.1090, 0.5200, 0.9943, 5000.0000, 139.5000, 0.0000, false,
+}
+
+var PresetMoodMemory = EAXReverb{
+	1.0000, 0.8500, 0.3162, 0.6310, 0.3548, 4.0600, 0.8200, 0.5600, 0.0398, 0.0000,
+	al.Vector{0.0, 0.0, 0.0}, 1.1220, 0.0000, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.4740, 0.4500, 0.9886, 5000.0000, 250.0000, 0.0000, false,
+}
+
+///// Driving ////////////////////////////////////////////////////////
+
+var PresetDrivingCommentator = EAXReverb{
+	1.0000, 0.0000, 0.3162, 0.5623, 0.5012, 2.4200, 0.8800, 0.6800, 0.1995, 0.0930,
+	al.Vector{0.0, 0.0, 0.0}, 0.2512, 0.0170, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 1.0000, 0.2500, 0.0000, 0.9886, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetDrivingPitGarage = EAXReverb{
+	0.4287, 0.5900, 0.3162, 0.7079, 0.5623, 1.7200, 0.9300, 0.8700, 0.5623, 0.0000,
+	al.Vector{0.0, 0.0, 0.0}, 1.2589, 0.0160, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.1100, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, false,
+}
+
+var PresetDrivingInCarRacer = EAXReverb{
+	0.0832, 0.8000, 0.3162, 1.0000, 0.7943, 0.1700, 2.0000, 0.4100, 1.7783, 0.0070,
+	al.Vector{0.0, 0.0, 0.0}, 0.7079, 0.0150, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 10268.2002, 251.0000, 0.0000, true,
+}
+
+var PresetDrivingInCarSports = EAXReverb{
+	0.0832, 0.8000, 0.3162, 0.6310, 1.0000, 0.1700, 0.7500, 0.4100, 1.0000, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 0.5623, 0.0000, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 10268.2002, 251.0000, 0.0000, true,
+}
+
+var PresetDrivingInCarLuxury = EAXReverb{
+	0.2560, 1.0000, 0.3162, 0.1000, 0.5012, 0.1300, 0.4100, 0.4600, 0.7943, 0.0100,
+	al.Vector{0.0, 0.0, 0.0}, 1.5849, 0.0100, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 10268.2002, 251.0000, 0.0000, true,
+}
+
+var PresetDrivingFullGrandstand = EAXReverb{
+	1.0000, 1.0000, 0.3162, 0.2818, 0.6310, 3.0100, 1.3700, 1.2800, 0.3548, 0.0900,
+	al.Vector{0.0, 0.0, 0.0}, 0.1778, 0.0490, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 10420.2002, 250.0000, 0.0000, false,
+}
+
+var PresetDrivingEmptyGrandstand = EAXReverb{
+	1.0000, 1.0000, 0.3162, 1.0000, 0.7943, 4.6200, 1.7500, 1.4000, 0.2082, 0.0900,
+	al.Vector{0.0, 0.0, 0.0}, 0.2512, 0.0490, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.0000, 0.9943, 10420.2002, 250.0000, 0.0000, false,
+}
+
+var PresetDrivingTunnel = EAXReverb{
+	1.0000, 0.8100, 0.3162, 0.3981, 0.8913, 3.4200, 0.9400, 1.3100, 0.7079, 0.0510,
+	al.Vector{0.0, 0.0, 0.0}, 0.7079, 0.0470, al.Vector{0.0, 0.0, 0.0},
+	0.2140, 0.0500, 0.2500, 0.0000, 0.9943, 5000.0000, 155.3000, 0.0000, true,
+}
+
+///// City ///////////////////////////////////////////////////////////
+
+var PresetCityStreets = EAXReverb{
+	1.0000, 0.7800, 0.3162, 0.7079, 0.8913, 1.7900, 1.1200, 0.9100, 0.2818, 0.0460,
+	al.Vector{0.0, 0.0, 0.0}, 0.1995, 0.0280, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.2000, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetCitySubway = EAXReverb{
+	1.0000, 0.7400, 0.3162, 0.7079, 0.8913, 3.0100, 1.2300, 0.9100, 0.7079, 0.0460,
+	al.Vector{0.0, 0.0, 0.0}, 1.2589, 0.0280, al.Vector{0.0, 0.0, 0.0},
+	0.1250, 0.2100, 0.2500, 0.0000, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetCityMuseum = EAXReverb{
+	1.0000, 0.8200, 0.3162, 0.1778, 0.1778, 3.2800, 1.4000, 0.5700, 0.2512, 0.0390,
+	al.Vector{0.0, 0.0, 0.0}, 0.8913, 0.0340, al.Vector{0.0, 0.0, 0.0},
+	0.1300, 0.1700, 0.2500, 0.0000, 0.9943, 2854.3999, 107.5000, 0.0000, false,
+}
+
+var PresetCityLibrary = EAXReverb{
+	1.0000, 0.8200, 0.3162, 0.2818, 0.0891, 2.7600, 0.8900, 0.4100, 0.3548, 0.0290,
+	al.Vector{0.0, 0.0, 0.0}, 0.8913, 0.0200, al.Vector{0.0, 0.0, 0.0},
+	0.1300, 0.1700, 0.2500, 0.0000, 0.9943, 2854.3999, 107.5000, 0.0000, false,
+}
+
+var PresetCityUnderpass = EAXReverb{
+	1.0000, 0.8200, 0.3162, 0.4467, 0.8913, 3.5700, 1.1200, 0.9100, 0.3981, 0.0590,
+	al.Vector{0.0, 0.0, 0.0}, 0.8913, 0.0370, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.1400, 0.2500, 0.0000, 0.9920, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetCityAbandoned = EAXReverb{
+	1.0000, 0.6900, 0.3162, 0.7943, 0.8913, 3.2800, 1.1700, 0.9100, 0.4467, 0.0440,
+	al.Vector{0.0, 0.0, 0.0}, 0.2818, 0.0240, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.2000, 0.2500, 0.0000, 0.9966, 5000.0000, 250.0000, 0.0000, true,
+}
+
+///// Misc ///////////////////////////////////////////////////////////
+
+var PresetDustyRoom = EAXReverb{
+	0.3645, 0.5600, 0.3162, 0.7943, 0.7079, 1.7900, 0.3800, 0.2100, 0.5012, 0.0020,
+	al.Vector{0.0, 0.0, 0.0}, 1.2589, 0.0060, al.Vector{0.0, 0.0, 0.0},
+	0.2020, 0.0500, 0.2500, 0.0000, 0.9886, 13046.0000, 163.3000, 0.0000, true,
+}
+
+var PresetChapel = EAXReverb{
+	1.0000, 0.8400, 0.3162, 0.5623, 1.0000, 4.6200, 0.6400, 1.2300, 0.4467, 0.0320,
+	al.Vector{0.0, 0.0, 0.0}, 0.7943, 0.0490, al.Vector{0.0, 0.0, 0.0},
+	0.2500, 0.0000, 0.2500, 0.1100, 0.9943, 5000.0000, 250.0000, 0.0000, true,
+}
+
+var PresetSmallWaterRoom = EAXReverb{
+	1.0000, 0.7000, 0.3162, 0.4477, 1.0000, 1.5100, 1.2500, 1.1400, 0.8913, 0.0200,
+	al.Vector{0.0, 0.0, 0.0}, 1.4125, 0.0300, al.Vector{0.0, 0.0, 0.0},
+	0.1790, 0.1500, 0.8950, 0.1900, 0.9920, 5000.0000, 250.0000, 0.0000, false,
+}
+
+// Presets maps preset names, in lower case and without
+// spaces, to the presets above. LookupPreset() is the
+// more forgiving way to use it.
+var Presets = map[string]EAXReverb{
+	"generic": PresetGeneric,
+	"paddedcell": PresetPaddedCell,
+	"room": PresetRoom,
+	"bathroom": PresetBathroom,
+	"livingroom": PresetLivingRoom,
+	"stoneroom": PresetStoneRoom,
+	"auditorium": PresetAuditorium,
+	"concerthall": PresetConcertHall,
+	"cave": PresetCave,
+	"arena": PresetArena,
+	"hangar": PresetHangar,
+	"carpetedhallway": PresetCarpetedHallway,
+	"hallway": PresetHallway,
+	"stonecorridor": PresetStoneCorridor,
+	"alley": PresetAlley,
+	"forest": PresetForest,
+	"city": PresetCity,
+	"mountains": PresetMountains,
+	"quarry": PresetQuarry,
+	"plain": PresetPlain,
+	"parkinglot": PresetParkingLot,
+	"sewerpipe": PresetSewerPipe,
+	"underwater": PresetUnderwater,
+	"drugged": PresetDrugged,
+	"dizzy": PresetDizzy,
+	"psychotic": PresetPsychotic,
+	// castle presets
+	"castlesmallroom": PresetCastleSmallRoom,
+	"castleshortpassage": PresetCastleShortPassage,
+	"castlemediumroom": PresetCastleMediumRoom,
+	"castlelargeroom": PresetCastleLargeRoom,
+	"castlelongpassage": PresetCastleLongPassage,
+	"castlehall": PresetCastleHall,
+	"castlecupboard": PresetCastleCupboard,
+	"castlecourtyard": PresetCastleCourtyard,
+	"castlealcove": PresetCastleAlcove,
+	// factory presets
+	"factorysmallroom": PresetFactorySmallRoom,
+	"factoryshortpassage": PresetFactoryShortPassage,
+	"factorymediumroom": PresetFactoryMediumRoom,
+	"factorylargeroom": PresetFactoryLargeRoom,
+	"factorylongpassage": PresetFactoryLongPassage,
+	"factoryhall": PresetFactoryHall,
+	"factorycupboard": PresetFactoryCupboard,
+	"factorycourtyard": PresetFactoryCourtyard,
+	"factoryalcove": PresetFactoryAlcove,
+	// ice palace presets
+	"icepalacesmallroom": PresetIcePalaceSmallRoom,
+	"icepalaceshortpassage": PresetIcePalaceShortPassage,
+	"icepalacemediumroom": PresetIcePalaceMediumRoom,
+	"icepalacelargeroom": PresetIcePalaceLargeRoom,
+	"icepalacelongpassage": PresetIcePalaceLongPassage,
+	"icepalacehall": PresetIcePalaceHall,
+	"icepalacecupboard": PresetIcePalaceCupboard,
+	"icepalacecourtyard": PresetIcePalaceCourtyard,
+	"icepalacealcove": PresetIcePalaceAlcove,
+	// space station presets
+	"spacestationsmallroom": PresetSpaceStationSmallRoom,
+	"spacestationshortpassage": PresetSpaceStationShortPassage,
+	"spacestationmediumroom": PresetSpaceStationMediumRoom,
+	"spacestationlargeroom": PresetSpaceStationLargeRoom,
+	"spacestationlongpassage": PresetSpaceStationLongPassage,
+	"spacestationhall": PresetSpaceStationHall,
+	"spacestationcupboard": PresetSpaceStationCupboard,
+	"spacestationalcove": PresetSpaceStationAlcove,
+	// wooden galleon presets
+	"woodensmallroom": PresetWoodenSmallRoom,
+	"woodenshortpassage": PresetWoodenShortPassage,
+	"woodenmediumroom": PresetWoodenMediumRoom,
+	"woodenlargeroom": PresetWoodenLargeRoom,
+	"woodenlongpassage": PresetWoodenLongPassage,
+	"woodenhall": PresetWoodenHall,
+	"woodencupboard": PresetWoodenCupboard,
+	"woodencourtyard": PresetWoodenCourtyard,
+	"woodenalcove": PresetWoodenAlcove,
+	// sports presets
+	"sportemptystadium": PresetSportEmptyStadium,
+	"sportsquashcourt": PresetSportSquashCourt,
+	"sportsmallswimmingpool": PresetSportSmallSwimmingPool,
+	"sportlargeswimmingpool": PresetSportLargeSwimmingPool,
+	"sportgymnasium": PresetSportGymnasium,
+	"sportfullstadium": PresetSportFullStadium,
+	"sportstadiumtannoy": PresetSportStadiumTannoy,
+	// prefab presets
+	"prefabworkshop": PresetPrefabWorkshop,
+	"prefabschoolroom": PresetPrefabSchoolRoom,
+	"prefabpractiseroom": PresetPrefabPractiseRoom,
+	"prefabouthouse": PresetPrefabOuthouse,
+	"prefabcaravan": PresetPrefabCaravan,
+	// dome and pipe presets
+	"dometomb": PresetDomeTomb,
+	"pipesmall": PresetPipeSmall,
+	"domesaintpauls": PresetDomeSaintPauls,
+	"pipelongthin": PresetPipeLongThin,
+	"pipelarge": PresetPipeLarge,
+	"piperesonant": PresetPipeResonant,
+	// outdoors presets
+	"outdoorsbackyard": PresetOutdoorsBackyard,
+	"outdoorsrollingplains": PresetOutdoorsRollingPlains,
+	"outdoorsdeepcanyon": PresetOutdoorsDeepCanyon,
+	"outdoorscreek": PresetOutdoorsCreek,
+	"outdoorsvalley": PresetOutdoorsValley,
+	// mood presets
+	"moodheaven": PresetMoodHeaven,
+	"moodhell": PresetMoodHell,
+	"moodmemory": PresetMoodMemory,
+	// driving presets
+	"drivingcommentator": PresetDrivingCommentator,
+	"drivingpitgarage": PresetDrivingPitGarage,
+	"drivingincarracer": PresetDrivingInCarRacer,
+	"drivingincarsports": PresetDrivingInCarSports,
+	"drivingincarluxury": PresetDrivingInCarLuxury,
+	"drivingfullgrandstand": PresetDrivingFullGrandstand,
+	"drivingemptygrandstand": PresetDrivingEmptyGrandstand,
+	"drivingtunnel": PresetDrivingTunnel,
+	// city presets
+	"citystreets": PresetCityStreets,
+	"citysubway": PresetCitySubway,
+	"citymuseum": PresetCityMuseum,
+	"citylibrary": PresetCityLibrary,
+	"cityunderpass": PresetCityUnderpass,
+	"cityabandoned": PresetCityAbandoned,
+	// misc presets
+	"dustyroom": PresetDustyRoom,
+	"chapel": PresetChapel,
+	"smallwaterroom": PresetSmallWaterRoom,
+}
+
+// LookupPreset() finds a preset by name. Case, spaces,
+// dashes and underscores don't matter, so "Stone Room",
+// "stone_room" and "STONEROOM" all find PresetStoneRoom.
+// The names from efx-presets.h work as well, for example
+// "EFX_REVERB_PRESET_CASTLE_SMALLROOM".
+func LookupPreset(name string) (preset EAXReverb, ok bool) {
+	key := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '_':
+			return -1;
+		}
+		return r;
+	}, strings.ToLower(name));
+	preset, ok = Presets[strings.TrimPrefix(key, "efxreverbpreset")];
+	return;
+}
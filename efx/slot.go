@@ -0,0 +1,136 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package efx
+
+/*
+#include <stdlib.h>
+#include <AL/al.h>
+#include "wrapper.h"
+*/
+import "C"
+import "unsafe"
+
+// AuxiliaryEffectSlots render an effect for all the
+// sources that send to them, see SetAuxiliarySend().
+type AuxiliaryEffectSlot uint32;
+
+// AuxiliaryEffectSlot properties.
+const (
+	alEffectSlotEffect = 0x0001;
+	alEffectSlotGain = 0x0002;
+	alEffectSlotAuxiliarySendAuto = 0x0003;
+)
+
+// NewAuxiliaryEffectSlots() creates n effect slots.
+// Renamed, was GenAuxiliaryEffectSlots.
+func NewAuxiliaryEffectSlots(n int) (slots []AuxiliaryEffectSlot) {
+	slots = make([]AuxiliaryEffectSlot, n);
+	C.walGenAuxiliaryEffectSlots(C.ALsizei(n), unsafe.Pointer(&slots[0]));
+	if debugging() {
+		debugCheck(n);
+	}
+	return;
+}
+
+// DeleteAuxiliaryEffectSlots() deletes the given effect slots.
+func DeleteAuxiliaryEffectSlots(slots []AuxiliaryEffectSlot) {
+	n := len(slots);
+	C.walDeleteAuxiliaryEffectSlots(C.ALsizei(n), unsafe.Pointer(&slots[0]));
+	if debugging() {
+		debugCheck(slots);
+	}
+}
+
+// NewAuxiliaryEffectSlot() creates a single effect slot.
+// Convenience function, see NewAuxiliaryEffectSlots().
+func NewAuxiliaryEffectSlot() AuxiliaryEffectSlot {
+	result := AuxiliaryEffectSlot(C.walGenAuxiliaryEffectSlot());
+	if debugging() {
+		debugCheck();
+	}
+	return result;
+}
+
+// DeleteAuxiliaryEffectSlot() deletes a single effect slot.
+// Convenience function, see DeleteAuxiliaryEffectSlots().
+func DeleteAuxiliaryEffectSlot(slot AuxiliaryEffectSlot) {
+	C.walDeleteAuxiliaryEffectSlot(C.ALuint(slot));
+	if debugging() {
+		debugCheck(slot);
+	}
+}
+
+// Renamed, was AuxiliaryEffectSloti.
+func (self AuxiliaryEffectSlot) seti(param int32, value int32) {
+	C.walAuxiliaryEffectSloti(C.ALuint(self), C.ALenum(param), C.ALint(value));
+	if debugging() {
+		debugCheck(self, param, value);
+	}
+}
+
+// Renamed, was AuxiliaryEffectSlotf.
+func (self AuxiliaryEffectSlot) setf(param int32, value float32) {
+	C.walAuxiliaryEffectSlotf(C.ALuint(self), C.ALenum(param), C.ALfloat(value));
+	if debugging() {
+		debugCheck(self, param, value);
+	}
+}
+
+// Renamed, was GetAuxiliaryEffectSloti.
+func (self AuxiliaryEffectSlot) geti(param int32) int32 {
+	result := int32(C.walGetAuxiliaryEffectSloti(C.ALuint(self), C.ALenum(param)));
+	if debugging() {
+		debugCheck(self, param);
+	}
+	return result;
+}
+
+// Renamed, was GetAuxiliaryEffectSlotf.
+func (self AuxiliaryEffectSlot) getf(param int32) float32 {
+	result := float32(C.walGetAuxiliaryEffectSlotf(C.ALuint(self), C.ALenum(param)));
+	if debugging() {
+		debugCheck(self, param);
+	}
+	return result;
+}
+
+///// Convenience ////////////////////////////////////////////////////
+
+// SetEffect() loads the effect into the slot. The slot
+// keeps a copy, so later changes to the effect need
+// another SetEffect() to be heard. Pass al.None as the
+// effect to empty the slot.
+// Convenience method, see AuxiliaryEffectSlot.Seti().
+func (self AuxiliaryEffectSlot) SetEffect(effect Effect) {
+	self.seti(alEffectSlotEffect, int32(effect));
+}
+
+// Convenience method, see AuxiliaryEffectSlot.Geti().
+func (self AuxiliaryEffectSlot) GetEffect() Effect {
+	return Effect(self.geti(alEffectSlotEffect));
+}
+
+// Convenience method, see AuxiliaryEffectSlot.Setf().
+func (self AuxiliaryEffectSlot) SetGain(gain float32) {
+	self.setf(alEffectSlotGain, gain);
+}
+
+// Convenience method, see AuxiliaryEffectSlot.Getf().
+func (self AuxiliaryEffectSlot) GetGain() float32 {
+	return self.getf(alEffectSlotGain);
+}
+
+// SetAuxiliarySendAuto() decides whether the reverb in
+// the slot is adjusted automatically for the distance
+// of each source sending to it.
+// Convenience method, see AuxiliaryEffectSlot.Seti().
+func (self AuxiliaryEffectSlot) SetAuxiliarySendAuto(yes bool) {
+	self.seti(alEffectSlotAuxiliarySendAuto, bool2al[yes]);
+}
+
+// Convenience method, see AuxiliaryEffectSlot.Geti().
+func (self AuxiliaryEffectSlot) GetAuxiliarySendAuto() bool {
+	return self.geti(alEffectSlotAuxiliarySendAuto) != alFalse;
+}
@@ -0,0 +1,253 @@
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include <stddef.h>
+#include <AL/al.h>
+#include "wrapper.h"
+
+// Same as WAL_PROC() in openal/al, check there for details.
+#define WAL_PROC(type, name) \
+	static type wal_##name(void) { \
+		static type proc = NULL; \
+		if (proc == NULL) { \
+			proc = (type) alGetProcAddress(#name); \
+		} \
+		return proc; \
+	}
+
+// The EFX functions for effects, filters and effect slots
+// all have the same signatures, so we get away with a few
+// function pointer types for all of them.
+
+typedef void (*walGenFunc)(ALsizei n, ALuint *objects);
+typedef void (*walDeleteFunc)(ALsizei n, const ALuint *objects);
+typedef void (*walSetiFunc)(ALuint id, ALenum param, ALint value);
+typedef void (*walSetfFunc)(ALuint id, ALenum param, ALfloat value);
+typedef void (*walSetfvFunc)(ALuint id, ALenum param, const ALfloat *values);
+typedef void (*walGetiFunc)(ALuint id, ALenum param, ALint *value);
+typedef void (*walGetfFunc)(ALuint id, ALenum param, ALfloat *value);
+typedef void (*walGetfvFunc)(ALuint id, ALenum param, ALfloat *values);
+
+// Effects
+
+WAL_PROC(walGenFunc, alGenEffects)
+WAL_PROC(walDeleteFunc, alDeleteEffects)
+WAL_PROC(walSetiFunc, alEffecti)
+WAL_PROC(walSetfFunc, alEffectf)
+WAL_PROC(walSetfvFunc, alEffectfv)
+WAL_PROC(walGetiFunc, alGetEffecti)
+WAL_PROC(walGetfFunc, alGetEffectf)
+WAL_PROC(walGetfvFunc, alGetEffectfv)
+
+void walGenEffects(ALsizei n, void *objects) {
+	walGenFunc f = wal_alGenEffects();
+	if (f != NULL) {
+		f(n, objects);
+	}
+}
+
+void walDeleteEffects(ALsizei n, const void *objects) {
+	walDeleteFunc f = wal_alDeleteEffects();
+	if (f != NULL) {
+		f(n, objects);
+	}
+}
+
+void walEffecti(ALuint id, ALenum param, ALint value) {
+	walSetiFunc f = wal_alEffecti();
+	if (f != NULL) {
+		f(id, param, value);
+	}
+}
+
+void walEffectf(ALuint id, ALenum param, ALfloat value) {
+	walSetfFunc f = wal_alEffectf();
+	if (f != NULL) {
+		f(id, param, value);
+	}
+}
+
+void walEffectfv(ALuint id, ALenum param, const void *values) {
+	walSetfvFunc f = wal_alEffectfv();
+	if (f != NULL) {
+		f(id, param, values);
+	}
+}
+
+ALint walGetEffecti(ALuint id, ALenum param) {
+	ALint result = 0;
+	walGetiFunc f = wal_alGetEffecti();
+	if (f != NULL) {
+		f(id, param, &result);
+	}
+	return result;
+}
+
+ALfloat walGetEffectf(ALuint id, ALenum param) {
+	ALfloat result = 0;
+	walGetfFunc f = wal_alGetEffectf();
+	if (f != NULL) {
+		f(id, param, &result);
+	}
+	return result;
+}
+
+void walGetEffectfv(ALuint id, ALenum param, void *values) {
+	walGetfvFunc f = wal_alGetEffectfv();
+	if (f != NULL) {
+		f(id, param, values);
+	}
+}
+
+
+// Filters
+
+WAL_PROC(walGenFunc, alGenFilters)
+WAL_PROC(walDeleteFunc, alDeleteFilters)
+WAL_PROC(walSetiFunc, alFilteri)
+WAL_PROC(walSetfFunc, alFilterf)
+WAL_PROC(walGetiFunc, alGetFilteri)
+WAL_PROC(walGetfFunc, alGetFilterf)
+
+void walGenFilters(ALsizei n, void *objects) {
+	walGenFunc f = wal_alGenFilters();
+	if (f != NULL) {
+		f(n, objects);
+	}
+}
+
+void walDeleteFilters(ALsizei n, const void *objects) {
+	walDeleteFunc f = wal_alDeleteFilters();
+	if (f != NULL) {
+		f(n, objects);
+	}
+}
+
+void walFilteri(ALuint id, ALenum param, ALint value) {
+	walSetiFunc f = wal_alFilteri();
+	if (f != NULL) {
+		f(id, param, value);
+	}
+}
+
+void walFilterf(ALuint id, ALenum param, ALfloat value) {
+	walSetfFunc f = wal_alFilterf();
+	if (f != NULL) {
+		f(id, param, value);
+	}
+}
+
+ALint walGetFilteri(ALuint id, ALenum param) {
+	ALint result = 0;
+	walGetiFunc f = wal_alGetFilteri();
+	if (f != NULL) {
+		f(id, param, &result);
+	}
+	return result;
+}
+
+ALfloat walGetFilterf(ALuint id, ALenum param) {
+	ALfloat result = 0;
+	walGetfFunc f = wal_alGetFilterf();
+	if (f != NULL) {
+		f(id, param, &result);
+	}
+	return result;
+}
+
+
+// AuxiliaryEffectSlots
+
+WAL_PROC(walGenFunc, alGenAuxiliaryEffectSlots)
+WAL_PROC(walDeleteFunc, alDeleteAuxiliaryEffectSlots)
+WAL_PROC(walSetiFunc, alAuxiliaryEffectSloti)
+WAL_PROC(walSetfFunc, alAuxiliaryEffectSlotf)
+WAL_PROC(walGetiFunc, alGetAuxiliaryEffectSloti)
+WAL_PROC(walGetfFunc, alGetAuxiliaryEffectSlotf)
+
+void walGenAuxiliaryEffectSlots(ALsizei n, void *objects) {
+	walGenFunc f = wal_alGenAuxiliaryEffectSlots();
+	if (f != NULL) {
+		f(n, objects);
+	}
+}
+
+void walDeleteAuxiliaryEffectSlots(ALsizei n, const void *objects) {
+	walDeleteFunc f = wal_alDeleteAuxiliaryEffectSlots();
+	if (f != NULL) {
+		f(n, objects);
+	}
+}
+
+void walAuxiliaryEffectSloti(ALuint id, ALenum param, ALint value) {
+	walSetiFunc f = wal_alAuxiliaryEffectSloti();
+	if (f != NULL) {
+		f(id, param, value);
+	}
+}
+
+void walAuxiliaryEffectSlotf(ALuint id, ALenum param, ALfloat value) {
+	walSetfFunc f = wal_alAuxiliaryEffectSlotf();
+	if (f != NULL) {
+		f(id, param, value);
+	}
+}
+
+ALint walGetAuxiliaryEffectSloti(ALuint id, ALenum param) {
+	ALint result = 0;
+	walGetiFunc f = wal_alGetAuxiliaryEffectSloti();
+	if (f != NULL) {
+		f(id, param, &result);
+	}
+	return result;
+}
+
+ALfloat walGetAuxiliaryEffectSlotf(ALuint id, ALenum param) {
+	ALfloat result = 0;
+	walGetfFunc f = wal_alGetAuxiliaryEffectSlotf();
+	if (f != NULL) {
+		f(id, param, &result);
+	}
+	return result;
+}
+
+// Listeners
+
+ALfloat walGetMetersPerUnit(void) {
+	ALfloat result;
+	alGetListenerf(0x20004, &result);
+	return result;
+}
+
+// Singulars
+
+ALuint walGenEffect(void) {
+	ALuint result = 0;
+	walGenEffects(1, &result);
+	return result;
+}
+
+void walDeleteEffect(ALuint id) {
+	walDeleteEffects(1, &id);
+}
+
+ALuint walGenFilter(void) {
+	ALuint result = 0;
+	walGenFilters(1, &result);
+	return result;
+}
+
+void walDeleteFilter(ALuint id) {
+	walDeleteFilters(1, &id);
+}
+
+ALuint walGenAuxiliaryEffectSlot(void) {
+	ALuint result = 0;
+	walGenAuxiliaryEffectSlots(1, &result);
+	return result;
+}
+
+void walDeleteAuxiliaryEffectSlot(ALuint id) {
+	walDeleteAuxiliaryEffectSlots(1, &id);
+}
@@ -0,0 +1,57 @@
+#ifndef _GO_WRAPPER_EFX_
+#define _GO_WRAPPER_EFX_
+
+// Copyright 2009 Peter H. Froehlich. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// All of EFX lives in extension functions, so every one
+// of these is a trampoline through an entry point we got
+// from alGetProcAddress(). See openal/al for the details
+// of how that works. The trampolines do nothing (or just
+// return zero) if EFX is not available.
+
+// Effects
+
+void walGenEffects(ALsizei n, void *objects);
+void walDeleteEffects(ALsizei n, const void *objects);
+void walEffecti(ALuint id, ALenum param, ALint value);
+void walEffectf(ALuint id, ALenum param, ALfloat value);
+void walEffectfv(ALuint id, ALenum param, const void *values);
+ALint walGetEffecti(ALuint id, ALenum param);
+ALfloat walGetEffectf(ALuint id, ALenum param);
+void walGetEffectfv(ALuint id, ALenum param, void *values);
+
+// Filters
+
+void walGenFilters(ALsizei n, void *objects);
+void walDeleteFilters(ALsizei n, const void *objects);
+void walFilteri(ALuint id, ALenum param, ALint value);
+void walFilterf(ALuint id, ALenum param, ALfloat value);
+ALint walGetFilteri(ALuint id, ALenum param);
+ALfloat walGetFilterf(ALuint id, ALenum param);
+
+// AuxiliaryEffectSlots
+
+void walGenAuxiliaryEffectSlots(ALsizei n, void *objects);
+void walDeleteAuxiliaryEffectSlots(ALsizei n, const void *objects);
+void walAuxiliaryEffectSloti(ALuint id, ALenum param, ALint value);
+void walAuxiliaryEffectSlotf(ALuint id, ALenum param, ALfloat value);
+ALint walGetAuxiliaryEffectSloti(ALuint id, ALenum param);
+ALfloat walGetAuxiliaryEffectSlotf(ALuint id, ALenum param);
+
+// Listeners
+
+ALfloat walGetMetersPerUnit(void);
+
+// For convenience we offer "singular" versions of these
+// calls as well, just like openal/al does.
+
+ALuint walGenEffect(void);
+void walDeleteEffect(ALuint id);
+ALuint walGenFilter(void);
+void walDeleteFilter(ALuint id);
+ALuint walGenAuxiliaryEffectSlot(void);
+void walDeleteAuxiliaryEffectSlot(ALuint id);
+
+#endif